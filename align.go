@@ -17,6 +17,12 @@ import (
 // The arguments to the function are a two-dimensional row-major array
 // of plots, a tile configuration, and the canvas to which the tiled
 // plots are to be drawn.
+// See AlignGrid for tiles of different sizes or spanning several
+// rows or columns.
+//
+// The canvases of the plots of the rows and columns holding a plot
+// with an Aspect constraint met by adjusting its box are shrunk,
+// so that their DataCanvases stay aligned with the constrained one.
 func Align(plots [][]*Plot, t draw.Tiles, dc draw.Canvas) [][]draw.Canvas {
 	o := make([][]draw.Canvas, len(plots))
 
@@ -50,7 +56,7 @@ func Align(plots [][]*Plot, t draw.Tiles, dc draw.Canvas) [][]draw.Canvas {
 				continue
 			}
 			c := o[j][i]
-			dataC := p.DataCanvas(o[j][i])
+			dataC := p.dataCanvas(o[j][i])
 			xSpacing[i].n = math.Max(float64(dataC.Min.X-c.Min.X), xSpacing[i].n)
 			xSpacing[i].p = math.Max(float64(c.Max.X-dataC.Max.X), xSpacing[i].p)
 			ySpacing[j].n = math.Max(float64(dataC.Min.Y-c.Min.Y), ySpacing[j].n)
@@ -81,7 +87,7 @@ func Align(plots [][]*Plot, t draw.Tiles, dc draw.Canvas) [][]draw.Canvas {
 			c := o[j][i]
 
 			if p != nil {
-				dataC := p.DataCanvas(c)
+				dataC := p.dataCanvas(c)
 				// Adjust the horizontal and vertical spacing between
 				// canvases to match the maximum for each column and row,
 				// respectively.
@@ -98,7 +104,7 @@ func Align(plots [][]*Plot, t draw.Tiles, dc draw.Canvas) [][]draw.Canvas {
 				width = c.Max.X - c.Min.X - vg.Length(xSpacing[i].p+xSpacing[i].n)
 				height = c.Max.Y - c.Min.Y - vg.Length(ySpacing[j].p+ySpacing[j].n)
 			} else {
-				dataC := p.DataCanvas(c)
+				dataC := p.dataCanvas(c)
 				width = dataC.Max.X - dataC.Min.X
 				height = dataC.Max.Y - dataC.Min.Y
 			}
@@ -115,5 +121,25 @@ func Align(plots [][]*Plot, t draw.Tiles, dc draw.Canvas) [][]draw.Canvas {
 			moveVertical[i] += avgHeight - height
 		}
 	}
+
+	// Shrink the rows and columns holding
+	// plots with an aspect constraint.
+	shrinkX := make([]vg.Length, t.Cols)
+	shrinkY := make([]vg.Length, t.Rows)
+	for j, row := range plots {
+		for i, p := range row {
+			if p == nil {
+				continue
+			}
+			dx, dy := p.aspectShrink(o[j][i])
+			shrinkX[i] = max(shrinkX[i], dx)
+			shrinkY[j] = max(shrinkY[j], dy)
+		}
+	}
+	for j := range o {
+		for i := range o[j] {
+			o[j][i] = draw.Crop(o[j][i], shrinkX[i], -shrinkX[i], shrinkY[j], -shrinkY[j])
+		}
+	}
 	return o
 }
@@ -0,0 +1,135 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// AspectMode specifies the meaning of the ratio of an Aspect.
+type AspectMode int
+
+const (
+	// AspectBox constrains the ratio of the height
+	// to the width of the data area of a plot.
+	AspectBox AspectMode = iota
+
+	// AspectData constrains the ratio of the length of
+	// one data unit along the Y axis to the length of
+	// one data unit along the X axis. A ratio of one
+	// gives axes with equal scales. It is intended for
+	// axes with linear scales.
+	AspectData
+)
+
+// AspectAdjust specifies how the aspect constraint of a plot is met.
+type AspectAdjust int
+
+const (
+	// AdjustBox shrinks the canvas of the plot, keeping
+	// it centered, so that its data area has the
+	// constrained aspect.
+	AdjustBox AspectAdjust = iota
+
+	// AdjustLimits expands the range of one of the axes
+	// of the plot, keeping it centered, so that the data
+	// area has the constrained aspect. The axes of the
+	// plot are not modified: the expanded ranges are used
+	// when the plot is drawn. It only applies to the
+	// AspectData mode. AspectBox is always met with
+	// AdjustBox.
+	AdjustLimits
+)
+
+// Aspect is an aspect ratio constraint on the data area of a plot.
+type Aspect struct {
+	// Ratio is the constrained ratio. A non-positive
+	// ratio leaves the aspect unconstrained.
+	Ratio float64
+
+	// Mode specifies the meaning of Ratio.
+	Mode AspectMode
+
+	// Adjust specifies how the constraint is met.
+	Adjust AspectAdjust
+}
+
+// EqualAspect returns an Aspect constraint for axes with equal
+// scales, met by adjusting the canvas of the plot.
+func EqualAspect() Aspect {
+	return Aspect{Ratio: 1, Mode: AspectData, Adjust: AdjustBox}
+}
+
+// aspect returns the plot and its canvas within c meeting the aspect
+// constraint of p. When the constraint is met by adjusting the limits,
+// the returned plot is a copy of p with the range of an axis expanded.
+func (p *Plot) aspect(c draw.Canvas) (*Plot, draw.Canvas) {
+	if p.Aspect.Ratio <= 0 {
+		return p, c
+	}
+	if p.Aspect.Mode == AspectData && p.Aspect.Adjust == AdjustLimits {
+		q := *p
+		// The tick labels, and thus the data area, depend
+		// on the range of the axes, so iterate a few times.
+		for range 3 {
+			q.adjustLimits(c)
+		}
+		return &q, c
+	}
+
+	dataC := p.dataCanvas(c)
+	w := float64(dataC.Max.X - dataC.Min.X)
+	h := float64(dataC.Max.Y - dataC.Min.Y)
+	if w <= 0 || h <= 0 {
+		return p, c
+	}
+	ratio := p.Aspect.Ratio
+	if p.Aspect.Mode == AspectData {
+		ratio *= (p.Y.Max - p.Y.Min) / (p.X.Max - p.X.Min)
+	}
+	if h/w > ratio {
+		dh := vg.Length(h-w*ratio) / 2
+		c.Min.Y += dh
+		c.Max.Y -= dh
+	} else {
+		dw := vg.Length(w-h/ratio) / 2
+		c.Min.X += dw
+		c.Max.X -= dw
+	}
+	return p, c
+}
+
+// aspectShrink returns the amounts by which the canvas c of
+// the plot is shrunk horizontally and vertically, on each
+// side, to meet the aspect constraint of the plot.
+func (p *Plot) aspectShrink(c draw.Canvas) (dx, dy vg.Length) {
+	_, a := p.aspect(c)
+	dx = ((c.Max.X - c.Min.X) - (a.Max.X - a.Min.X)) / 2
+	dy = ((c.Max.Y - c.Min.Y) - (a.Max.Y - a.Min.Y)) / 2
+	return dx, dy
+}
+
+// adjustLimits expands the range of an axis of the plot
+// drawn to c to meet its AspectData constraint.
+func (p *Plot) adjustLimits(c draw.Canvas) {
+	dataC := p.dataCanvas(c)
+	w := float64(dataC.Max.X - dataC.Min.X)
+	h := float64(dataC.Max.Y - dataC.Min.Y)
+	if w <= 0 || h <= 0 {
+		return
+	}
+	// want is the ratio of the ranges of
+	// the axes meeting the constraint.
+	want := h / w / p.Aspect.Ratio
+	xr, yr := p.X.Max-p.X.Min, p.Y.Max-p.Y.Min
+	if yr/xr < want {
+		mid := (p.Y.Min + p.Y.Max) / 2
+		p.Y.Min, p.Y.Max = mid-want*xr/2, mid+want*xr/2
+	} else {
+		mid := (p.X.Min + p.X.Max) / 2
+		p.X.Min, p.X.Max = mid-yr/want/2, mid+yr/want/2
+	}
+}
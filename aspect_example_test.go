@@ -0,0 +1,55 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+)
+
+// ExampleEqualAspect draws circles on plots with axes of equal
+// scales, met by shrinking the data area of the plot or by
+// expanding the range of its axes.
+func ExampleEqualAspect() {
+	circle := make(plotter.XYs, 101)
+	for i := range circle {
+		a := 2 * math.Pi * float64(i) / float64(len(circle)-1)
+		circle[i].X = 1 + 2*math.Cos(a)
+		circle[i].Y = 2 * math.Sin(a)
+	}
+	newPlot := func(title string) *plot.Plot {
+		l, err := plotter.NewLine(circle)
+		if err != nil {
+			log.Panic(err)
+		}
+		p := plot.New()
+		p.Title.Text = title
+		p.Add(plotter.NewGrid(), l)
+		return p
+	}
+
+	box := newPlot("Adjust box")
+	box.Aspect = plot.EqualAspect()
+
+	limits := newPlot("Adjust limits")
+	limits.Aspect = plot.EqualAspect()
+	limits.Aspect.Adjust = plot.AdjustLimits
+
+	ratio := newPlot("Box ratio 1/2")
+	ratio.Aspect = plot.Aspect{Ratio: 0.5, Mode: plot.AspectBox}
+
+	fig := plot.NewFigure(1, 3)
+	fig.Add(box, 0, 0)
+	fig.Add(limits, 0, 1)
+	fig.Add(ratio, 0, 2)
+
+	err := fig.Save(450, 200, "testdata/aspect.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
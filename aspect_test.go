@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestEqualAspect(t *testing.T) {
+	cmpimg.CheckPlot(ExampleEqualAspect, t, "aspect.png")
+}
+
+func TestAspect(t *testing.T) {
+	const tol = 1e-2
+	c := draw.NewCanvas(new(recorder.Canvas), vg.Points(400), vg.Points(200))
+	for _, test := range []struct {
+		aspect plot.Aspect
+		want   float64
+	}{
+		{aspect: plot.Aspect{Ratio: 2, Mode: plot.AspectBox}, want: 2},
+		{aspect: plot.Aspect{Ratio: 0.25, Mode: plot.AspectBox}, want: 0.25},
+		{aspect: plot.Aspect{Ratio: 1, Mode: plot.AspectData}, want: 1},
+		{aspect: plot.Aspect{Ratio: 3, Mode: plot.AspectData}, want: 3},
+		{aspect: plot.Aspect{Ratio: 1, Mode: plot.AspectData, Adjust: plot.AdjustLimits}, want: 1},
+		{aspect: plot.Aspect{Ratio: 0.5, Mode: plot.AspectData, Adjust: plot.AdjustLimits}, want: 0.5},
+	} {
+		p := plot.New()
+		p.X.Min, p.X.Max = 0, 10
+		p.Y.Min, p.Y.Max = 0, 4
+		p.Aspect = test.aspect
+		var drawn axesRecorder
+		p.Add(&drawn)
+
+		dataC := p.DataCanvas(c)
+		p.Draw(c)
+		if p.X.Min != 0 || p.X.Max != 10 || p.Y.Min != 0 || p.Y.Max != 4 {
+			t.Errorf("unexpected modified axes for %+v: X:[%v, %v] Y:[%v, %v]",
+				test.aspect, p.X.Min, p.X.Max, p.Y.Min, p.Y.Max)
+		}
+		if drawn.c.Rectangle != dataC.Rectangle {
+			t.Errorf("unexpected drawn data canvas for %+v: got:%v want:%v", test.aspect, drawn.c.Rectangle, dataC.Rectangle)
+		}
+
+		size := dataC.Size()
+		var got float64
+		switch test.aspect.Mode {
+		case plot.AspectBox:
+			got = float64(size.Y / size.X)
+		case plot.AspectData:
+			got = (float64(size.Y) / (drawn.y.Max - drawn.y.Min)) / (float64(size.X) / (drawn.x.Max - drawn.x.Min))
+		}
+		if math.Abs(got-test.want) > tol {
+			t.Errorf("unexpected aspect for %+v: got:%v want:%v", test.aspect, got, test.want)
+		}
+		if !c.Contains(dataC.Min) || !c.Contains(dataC.Max) {
+			t.Errorf("data canvas out of canvas for %+v: %v", test.aspect, dataC.Rectangle)
+		}
+	}
+}
+
+// axesRecorder is a plotter recording the data canvas
+// and the axes of the plot it is drawn with.
+type axesRecorder struct {
+	c    draw.Canvas
+	x, y plot.Axis
+}
+
+func (r *axesRecorder) Plot(c draw.Canvas, p *plot.Plot) {
+	r.c, r.x, r.y = c, p.X, p.Y
+}
+
+func TestAlignAspect(t *testing.T) {
+	const (
+		rows, cols = 2, 2
+		tol        = 1e-6
+	)
+	newPlots := func() [][]*plot.Plot {
+		plots := make([][]*plot.Plot, rows)
+		for j := range plots {
+			plots[j] = make([]*plot.Plot, cols)
+			for i := range plots[j] {
+				p := plot.New()
+				p.X.Min, p.X.Max = 0, 10
+				p.Y.Min, p.Y.Max = 0, 10
+				plots[j][i] = p
+			}
+		}
+		// The data area of the top left plot
+		// is shrunk vertically by its aspect.
+		plots[0][0].Y.Max = 5
+		plots[0][0].Aspect = plot.EqualAspect()
+		return plots
+	}
+	eq := func(a, b vg.Length) bool {
+		return math.Abs(float64(a-b)) < tol
+	}
+	check := func(name string, plots [][]*plot.Plot, cs [][]draw.Canvas) {
+		t.Helper()
+		data := make([][]draw.Canvas, rows)
+		for j := range data {
+			data[j] = make([]draw.Canvas, cols)
+			for i := range data[j] {
+				data[j][i] = plots[j][i].DataCanvas(cs[j][i])
+			}
+		}
+		size := data[0][0].Size()
+		if got := float64(size.Y / size.X); math.Abs(got-0.5) > 1e-2 {
+			t.Errorf("%s: unexpected aspect of the constrained plot: got:%v want:0.5", name, got)
+		}
+		for j := range rows {
+			for i := range cols {
+				d, row, col := data[j][i], data[j][0], data[0][i]
+				if !eq(d.Min.Y, row.Min.Y) || !eq(d.Max.Y, row.Max.Y) {
+					t.Errorf("%s: data area (%d,%d) not aligned with its row: %v %v", name, j, i, d.Rectangle, row.Rectangle)
+				}
+				if !eq(d.Min.X, col.Min.X) || !eq(d.Max.X, col.Max.X) {
+					t.Errorf("%s: data area (%d,%d) not aligned with its column: %v %v", name, j, i, d.Rectangle, col.Rectangle)
+				}
+			}
+		}
+	}
+
+	dc := draw.NewCanvas(new(recorder.Canvas), vg.Points(400), vg.Points(300))
+	tiles := draw.Tiles{Rows: rows, Cols: cols, PadX: vg.Millimeter, PadY: vg.Millimeter}
+
+	plots := newPlots()
+	check("Align", plots, plot.Align(plots, tiles, dc))
+
+	plots = newPlots()
+	var cells []plot.GridCell
+	for j, row := range plots {
+		for i, p := range row {
+			cells = append(cells, plot.GridCell{Plot: p, Row: j, Col: i})
+		}
+	}
+	grid := plot.AlignGrid(cells, plot.Grid{Tiles: tiles}, dc)
+	cs := make([][]draw.Canvas, rows)
+	for j := range cs {
+		cs[j] = grid[j*cols : (j+1)*cols]
+	}
+	check("AlignGrid", plots, cs)
+}
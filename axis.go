@@ -56,6 +56,14 @@ type Axis struct {
 		// Valid values are [-1,+1], with +1 being the far right/top
 		// of the axis, and -1 the far left/bottom of the axis.
 		Position float64
+
+		// Wrap specifies how the lines of the axis
+		// label are laid out within a maximum width.
+		Wrap text.Wrap
+
+		// Hide hides the axis label, keeping its Text,
+		// and removes the space reserved for the label.
+		Hide bool
 	}
 
 	// LineStyle is the style of the axis line.
@@ -63,9 +71,30 @@ type Axis struct {
 
 	// Padding between the axis line and the data.  Having
 	// non-zero padding ensures that the data is never drawn
-	// on the axis, thus making it easier to see.
+	// on the axis, thus making it easier to see. It is the
+	// outward offset of the axis from the data area.
 	Padding vg.Length
 
+	// Position specifies where the axis is drawn.
+	// The default, AxisEdge, draws the axis along
+	// the edge of the data area.
+	Position AxisPosition
+
+	// Cross is the value of the other axis of the plot at
+	// which the axis is drawn when Position is AxisCross.
+	// Values outside of the range of the other axis are
+	// clamped to the edges of the data area.
+	Cross float64
+
+	// Mirror specifies the elements of the axis drawn
+	// along the opposite edge of the data area: the top
+	// edge for the X axis and the right edge for the Y
+	// axis. The mirrored elements are drawn Padding away
+	// from the data area, with the styles of Mirror. The
+	// axis label is not mirrored, and the mirrored tick
+	// labels are drawn regardless of Tick.HideLabels.
+	Mirror AxisSide
+
 	Tick struct {
 		// Label is the TextStyle on the tick labels.
 		Label text.Style
@@ -82,6 +111,17 @@ type Axis struct {
 		// returned by the Marker function that are not in
 		// range of the axis are not drawn.
 		Marker Ticker
+
+		// HideLabels hides the tick labels, keeping the
+		// tick marks, and removes the space reserved for
+		// the labels.
+		HideLabels bool
+
+		// Overlap specifies how the tick labels are laid
+		// out when they would overlap each other. The
+		// space needed by the resulting layout is reserved
+		// for the axis when the plot is drawn.
+		Overlap TickOverlap
 	}
 
 	// Scale transforms a value given in the data coordinate system
@@ -94,6 +134,82 @@ type Axis struct {
 	AutoRescale bool
 }
 
+// AxisPosition specifies where an axis is drawn.
+type AxisPosition int
+
+const (
+	// AxisEdge draws the axis along the lower edge of
+	// the data area for the X axis, and along its left
+	// edge for the Y axis, outside of the data area.
+	AxisEdge AxisPosition = iota
+
+	// AxisCross draws the axis within the data area,
+	// crossing the other axis at the value Axis.Cross.
+	// No space is reserved for the axis around the data
+	// area and its label is drawn at the end of the axis:
+	// at the right for the X axis and at the top for the
+	// Y axis.
+	AxisCross
+)
+
+// AxisSide specifies which elements of an axis
+// are drawn along a side of the data area, and
+// the styles of its line and tick marks.
+//
+// On the side where the axis is drawn, the axis line,
+// tick marks and tick labels are hidden by setting the
+// Width of its LineStyle to zero, its Tick.Length to
+// zero and its Tick.HideLabels to true. This does not
+// change the elements drawn by an AxisSide, which
+// does not use the styles of its axis.
+type AxisSide struct {
+	// Line, Ticks and Labels specify whether the axis
+	// line, the tick marks and the tick labels are drawn.
+	// The tick labels use the Tick.Label style of the
+	// axis.
+	Line, Ticks, Labels bool
+
+	// LineStyle is the style of the line.
+	LineStyle draw.LineStyle
+
+	// TickStyle is the style of the tick marks.
+	TickStyle draw.LineStyle
+
+	// TickLength is the length of a major tick mark.
+	// Minor tick marks are half of the length of major
+	// tick marks.
+	TickLength vg.Length
+}
+
+// Side returns an AxisSide drawing the line, the tick marks
+// and the tick labels of the axis as specified, with the line
+// style, the tick style and the tick length of the axis.
+func (a *Axis) Side(line, ticks, labels bool) AxisSide {
+	return AxisSide{
+		Line:       line,
+		Ticks:      ticks,
+		Labels:     labels,
+		LineStyle:  a.LineStyle,
+		TickStyle:  a.Tick.LineStyle,
+		TickLength: a.Tick.Length,
+	}
+}
+
+// drawTicks returns true if the tick marks of the side
+// should be drawn.
+func (s AxisSide) drawTicks() bool {
+	return s.Ticks && s.TickStyle.Width > 0 && s.TickLength > 0
+}
+
+// lineWidth returns the width of the line of the side,
+// or zero if it is not drawn.
+func (s AxisSide) lineWidth() vg.Length {
+	if !s.Line {
+		return 0
+	}
+	return s.LineStyle.Width
+}
+
 // makeAxis returns a default Axis.
 //
 // The default range is (∞, ­∞), and thus any finite
@@ -175,6 +291,18 @@ func (a *Axis) sanitizeRange() {
 	}
 }
 
+// wrapLabel returns a copy of the axis with the text
+// of its label wrapped within its maximum width, or
+// removed when the label is hidden.
+func (a Axis) wrapLabel() Axis {
+	if a.Label.Hide {
+		a.Label.Text = ""
+		return a
+	}
+	a.Label.Text = a.Label.Wrap.Text(a.Label.TextStyle, a.Label.Text)
+	return a
+}
+
 // LinearScale an be used as the value of an Axis.Scale function to
 // set the axis to a standard linear scale.
 type LinearScale struct{}
@@ -226,6 +354,24 @@ func (a Axis) drawTicks() bool {
 	return a.Tick.Width > 0 && a.Tick.Length > 0
 }
 
+// annotation returns the annotation of the tick labels
+// of the axis, if its Ticker is a TickAnnotator.
+func (a Axis) annotation() string {
+	an, ok := a.Tick.Marker.(TickAnnotator)
+	if !ok || a.Tick.HideLabels {
+		return ""
+	}
+	return an.Annotation(a.Min, a.Max)
+}
+
+// labels returns the tick marks to be labeled.
+func (a Axis) labels(marks []Tick) []Tick {
+	if a.Tick.HideLabels {
+		return nil
+	}
+	return marks
+}
+
 // A horizontalAxis draws horizontally across the bottom
 // of a plot.
 type horizontalAxis struct {
@@ -234,17 +380,23 @@ type horizontalAxis struct {
 
 // size returns the height of the axis.
 func (a horizontalAxis) size() (h vg.Length) {
+	if a.Position == AxisCross {
+		return 0
+	}
 	if a.Label.Text != "" { // We assume that the label isn't rotated.
 		h += a.Label.TextStyle.Height(a.Label.Text)
 		h += a.Label.Padding
 	}
+	if an := a.annotation(); an != "" {
+		h += a.Tick.Label.Height(an)
+	}
 
 	marks := a.Tick.Marker.Ticks(a.Min, a.Max)
 	if len(marks) > 0 {
 		if a.drawTicks() {
 			h += a.Tick.Length
 		}
-		h += tickLabelHeight(a.Tick.Label, marks)
+		h += tickLabelHeight(a.Tick.Label, a.labels(marks))
 	}
 	h += a.Width / 2
 	h += a.Padding
@@ -271,11 +423,17 @@ func (a horizontalAxis) draw(c draw.Canvas) {
 		y += a.Label.TextStyle.Height(a.Label.Text)
 		y += a.Label.Padding
 	}
+	if an := a.annotation(); an != "" {
+		sty := a.annotationStyle()
+		descent := sty.FontExtents().Descent
+		c.FillText(sty, vg.Point{X: c.Max.X, Y: y + descent}, an)
+		y += sty.Height(an)
+	}
 
 	marks := a.Tick.Marker.Ticks(a.Min, a.Max)
-	ticklabelheight := tickLabelHeight(a.Tick.Label, marks)
+	ticklabelheight := tickLabelHeight(a.Tick.Label, a.labels(marks))
 	descent := a.Tick.Label.FontExtents().Descent
-	for _, t := range marks {
+	for _, t := range a.labels(marks) {
 		x := c.X(a.Norm(t.Value))
 		if !c.ContainsX(x) || t.IsMinor() {
 			continue
@@ -305,6 +463,103 @@ func (a horizontalAxis) draw(c draw.Canvas) {
 	c.StrokeLine2(a.LineStyle, c.Min.X, y, c.Max.X, y)
 }
 
+// drawCross draws the axis across a draw.Canvas at y,
+// with its label at the right end of the axis.
+func (a horizontalAxis) drawCross(c draw.Canvas, y vg.Length) {
+	edge := a
+	edge.Position = AxisEdge
+	edge.Label.Text = ""
+	edge.Padding = 0
+	c.Min.Y = y - (edge.size() - edge.Width/2)
+	edge.draw(c)
+
+	if a.Label.Text != "" {
+		sty := a.crossLabelStyle()
+		descent := sty.FontExtents().Descent
+		c.FillText(sty, vg.Point{X: c.Max.X, Y: y + a.Width/2 + a.Label.Padding + descent}, a.Label.Text)
+	}
+}
+
+// crossLabelStyle returns the style of the
+// label of the axis drawn with AxisCross.
+func (a horizontalAxis) crossLabelStyle() text.Style {
+	sty := a.Label.TextStyle
+	sty.XAlign = draw.XRight
+	sty.YAlign = draw.YBottom
+	return sty
+}
+
+// annotationStyle returns the style of the
+// annotation of the tick labels of the axis.
+func (a horizontalAxis) annotationStyle() text.Style {
+	sty := a.Tick.Label
+	sty.XAlign = draw.XRight
+	sty.YAlign = draw.YBottom
+	return sty
+}
+
+// mirrorSize returns the height of the
+// mirrored elements of the axis.
+func (a horizontalAxis) mirrorSize() (h vg.Length) {
+	m := a.Mirror
+	if !m.Line && !m.Ticks && !m.Labels {
+		return 0
+	}
+	h += a.Padding
+	h += m.lineWidth() / 2
+
+	marks := a.Tick.Marker.Ticks(a.Min, a.Max)
+	if len(marks) > 0 {
+		if m.drawTicks() {
+			h += m.TickLength
+		}
+		if m.Labels {
+			h += tickLabelHeight(a.Tick.Label, marks)
+		}
+	}
+	return h
+}
+
+// drawMirror draws the mirrored elements of the axis
+// upward from the lower edge of a draw.Canvas.
+func (a horizontalAxis) drawMirror(c draw.Canvas) {
+	m := a.Mirror
+	y := c.Min.Y + a.Padding + m.lineWidth()/2
+	if m.Line {
+		c.StrokeLine2(m.LineStyle, c.Min.X, y, c.Max.X, y)
+	}
+
+	marks := a.Tick.Marker.Ticks(a.Min, a.Max)
+	if len(marks) == 0 {
+		return
+	}
+	if m.drawTicks() {
+		len := m.TickLength
+		for _, t := range marks {
+			x := c.X(a.Norm(t.Value))
+			if !c.ContainsX(x) {
+				continue
+			}
+			start := t.lengthOffset(len)
+			c.StrokeLine2(m.TickStyle, x, y, x, y+len-start)
+		}
+		y += len
+	}
+	if !m.Labels {
+		return
+	}
+	sty := a.Tick.Label
+	sty.YAlign = draw.YBottom
+	descent := sty.FontExtents().Descent
+	for _, t := range marks {
+		x := c.X(a.Norm(t.Value))
+		if !c.ContainsX(x) || t.IsMinor() {
+			continue
+		}
+		c.FillText(sty, vg.Point{X: x, Y: y + descent}, t.Label)
+	}
+}
+
 // GlyphBoxes returns the GlyphBoxes for the tick labels.
 func (a horizontalAxis) GlyphBoxes(p *Plot) []GlyphBox {
 	var (
@@ -312,7 +567,14 @@ func (a horizontalAxis) GlyphBoxes(p *Plot) []GlyphBox {
 		yoff  font.Length
 	)
 
-	if a.Label.Text != "" {
+	switch {
+	case a.Label.Text == "":
+	case a.Position == AxisCross:
+		boxes = append(boxes, GlyphBox{
+			X:         1,
+			Rectangle: a.crossLabelStyle().Rectangle(a.Label.Text),
+		})
+	default:
 		x := a.Norm(p.X.Max)
 		switch a.Label.Position {
 		case draw.PosCenter:
@@ -328,9 +590,18 @@ func (a horizontalAxis) GlyphBoxes(p *Plot) []GlyphBox {
 		yoff += a.Label.TextStyle.Height(a.Label.Text)
 		yoff += a.Label.Padding
 	}
+	if an := a.annotation(); an != "" {
+		sty := a.annotationStyle()
+		descent := sty.FontExtents().Descent
+		boxes = append(boxes, GlyphBox{
+			X:         1,
+			Rectangle: sty.Rectangle(an).Add(vg.Point{Y: yoff + descent}),
+		})
+		yoff += sty.Height(an)
+	}
 
 	var (
-		marks   = a.Tick.Marker.Ticks(a.Min, a.Max)
+		marks   = a.labels(a.Tick.Marker.Ticks(a.Min, a.Max))
 		height  = tickLabelHeight(a.Tick.Label, marks)
 		descent = a.Tick.Label.FontExtents().Descent
 	)
@@ -354,6 +625,9 @@ type verticalAxis struct {
 
 // size returns the width of the axis.
 func (a verticalAxis) size() (w vg.Length) {
+	if a.Position == AxisCross {
+		return 0
+	}
 	if a.Label.Text != "" { // We assume that the label isn't rotated.
 		w += a.Label.TextStyle.FontExtents().Descent
 		w += a.Label.TextStyle.Height(a.Label.Text)
@@ -362,7 +636,7 @@ func (a verticalAxis) size() (w vg.Length) {
 
 	marks := a.Tick.Marker.Ticks(a.Min, a.Max)
 	if len(marks) > 0 {
-		if lwidth := tickLabelWidth(a.Tick.Label, marks); lwidth > 0 {
+		if lwidth := tickLabelWidth(a.Tick.Label, a.labels(marks)); lwidth > 0 {
 			w += lwidth
 			w += a.Label.TextStyle.Width(" ")
 		}
@@ -399,13 +673,13 @@ func (a verticalAxis) draw(c draw.Canvas) {
 		x += a.Label.Padding
 	}
 	marks := a.Tick.Marker.Ticks(a.Min, a.Max)
-	if w := tickLabelWidth(a.Tick.Label, marks); len(marks) > 0 && w > 0 {
+	if w := tickLabelWidth(a.Tick.Label, a.labels(marks)); len(marks) > 0 && w > 0 {
 		x += w
 	}
 
 	major := false
 	descent := a.Tick.Label.FontExtents().Descent
-	for _, t := range marks {
+	for _, t := range a.labels(marks) {
 		y := c.Y(a.Norm(t.Value))
 		if !c.ContainsY(y) || t.IsMinor() {
 			continue
@@ -430,6 +704,124 @@ func (a verticalAxis) draw(c draw.Canvas) {
 	}
 
 	c.StrokeLine2(a.LineStyle, x, c.Min.Y, x, c.Max.Y)
+
+	if an := a.annotation(); an != "" {
+		sty := a.annotationStyle()
+		descent := sty.FontExtents().Descent
+		c.FillText(sty, vg.Point{X: x, Y: c.Max.Y + descent}, an)
+	}
+}
+
+// drawCross draws the axis up a draw.Canvas at x,
+// with its label at the top end of the axis.
+func (a verticalAxis) drawCross(c draw.Canvas, x vg.Length) {
+	edge := a
+	edge.Position = AxisEdge
+	edge.Label.Text = ""
+	edge.Padding = 0
+	c.Min.X = x - (edge.size() - edge.Width/2)
+	edge.draw(c)
+
+	if a.Label.Text != "" {
+		sty := a.crossLabelStyle()
+		c.FillText(sty, vg.Point{X: x + a.Width/2 + a.Label.Padding, Y: c.Max.Y}, a.Label.Text)
+	}
+}
+
+// crossLabelStyle returns the style of the
+// label of the axis drawn with AxisCross.
+// The label is not rotated.
+func (a verticalAxis) crossLabelStyle() text.Style {
+	sty := a.Label.TextStyle
+	sty.XAlign = draw.XLeft
+	sty.YAlign = draw.YTop
+	return sty
+}
+
+// annotationSize returns the height of the annotation
+// of the tick labels of the axis, drawn above its top end.
+func (a verticalAxis) annotationSize() vg.Length {
+	an := a.annotation()
+	if an == "" {
+		return 0
+	}
+	return a.Tick.Label.Height(an)
+}
+
+// annotationStyle returns the style of the
+// annotation of the tick labels of the axis.
+func (a verticalAxis) annotationStyle() text.Style {
+	sty := a.Tick.Label
+	sty.XAlign = draw.XLeft
+	sty.YAlign = draw.YBottom
+	return sty
+}
+
+// mirrorSize returns the width of the
+// mirrored elements of the axis.
+func (a verticalAxis) mirrorSize() (w vg.Length) {
+	m := a.Mirror
+	if !m.Line && !m.Ticks && !m.Labels {
+		return 0
+	}
+	w += a.Padding
+	w += m.lineWidth() / 2
+
+	marks := a.Tick.Marker.Ticks(a.Min, a.Max)
+	if len(marks) > 0 {
+		if m.drawTicks() {
+			w += m.TickLength
+		}
+		if !m.Labels {
+			return w
+		}
+		if lwidth := tickLabelWidth(a.Tick.Label, marks); lwidth > 0 {
+			w += lwidth
+			w += a.Tick.Label.Width(" ")
+		}
+	}
+	return w
+}
+
+// drawMirror draws the mirrored elements of the axis
+// rightward from the left edge of a draw.Canvas.
+func (a verticalAxis) drawMirror(c draw.Canvas) {
+	m := a.Mirror
+	x := c.Min.X + a.Padding + m.lineWidth()/2
+	if m.Line {
+		c.StrokeLine2(m.LineStyle, x, c.Min.Y, x, c.Max.Y)
+	}
+
+	marks := a.Tick.Marker.Ticks(a.Min, a.Max)
+	if len(marks) == 0 {
+		return
+	}
+	if m.drawTicks() {
+		len := m.TickLength
+		for _, t := range marks {
+			y := c.Y(a.Norm(t.Value))
+			if !c.ContainsY(y) {
+				continue
+			}
+			start := t.lengthOffset(len)
+			c.StrokeLine2(m.TickStyle, x, y, x+len-start, y)
+		}
+		x += len
+	}
+	if !m.Labels {
+		return
+	}
+	sty := a.Tick.Label
+	sty.XAlign = draw.XLeft
+	x += sty.Width(" ")
+	descent := sty.FontExtents().Descent
+	for _, t := range marks {
+		y := c.Y(a.Norm(t.Value))
+		if !c.ContainsY(y) || t.IsMinor() {
+			continue
+		}
+		c.FillText(sty, vg.Point{X: x, Y: y + descent}, t.Label)
+	}
 }
 
 // GlyphBoxes returns the GlyphBoxes for the tick labels
@@ -439,7 +831,14 @@ func (a verticalAxis) GlyphBoxes(p *Plot) []GlyphBox {
 		xoff  font.Length
 	)
 
-	if a.Label.Text != "" {
+	switch {
+	case a.Label.Text == "":
+	case a.Position == AxisCross:
+		boxes = append(boxes, GlyphBox{
+			Y:         1,
+			Rectangle: a.crossLabelStyle().Rectangle(a.Label.Text),
+		})
+	default:
 		yoff := a.Norm(p.Y.Max)
 		switch a.Label.Position {
 		case draw.PosCenter:
@@ -461,7 +860,7 @@ func (a verticalAxis) GlyphBoxes(p *Plot) []GlyphBox {
 		xoff += a.Label.Padding
 	}
 
-	marks := a.Tick.Marker.Ticks(a.Min, a.Max)
+	marks := a.labels(a.Tick.Marker.Ticks(a.Min, a.Max))
 	if w := tickLabelWidth(a.Tick.Label, marks); len(marks) != 0 && w > 0 {
 		xoff += w
 	}
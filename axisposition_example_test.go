@@ -0,0 +1,58 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExampleAxisPosition draws plots with axes crossing at the origin,
+// with a box frame, and with outward axes whose tick marks are drawn
+// on all four sides and tick labels on the bottom and right sides.
+func ExampleAxisPosition() {
+	fn := plotter.NewFunction(math.Sin)
+	fn.Samples = 100
+	newPlot := func(title string) *plot.Plot {
+		p := plot.New()
+		p.Title.Text = title
+		p.X.Label.Text = "x"
+		p.Y.Label.Text = "sin(x)"
+		p.X.Min, p.X.Max = -2*math.Pi, 2*math.Pi
+		p.Y.Min, p.Y.Max = -1.5, 1.5
+		p.Add(fn)
+		return p
+	}
+
+	cross := newPlot("Crossing axes")
+	cross.X.Position = plot.AxisCross
+	cross.Y.Position = plot.AxisCross
+	cross.X.Padding = 0
+	cross.Y.Padding = 0
+
+	frame := newPlot("Box frame")
+	frame.Frame()
+
+	sides := newPlot("Mirrored sides")
+	sides.X.Padding = vg.Points(10)
+	sides.Y.Padding = vg.Points(10)
+	sides.X.Mirror = sides.X.Side(false, true, false)
+	sides.Y.Mirror = sides.Y.Side(true, true, true)
+	sides.Y.Tick.HideLabels = true
+
+	fig := plot.NewFigure(1, 3)
+	fig.Add(cross, 0, 0)
+	fig.Add(frame, 0, 1)
+	fig.Add(sides, 0, 2)
+
+	err := fig.Save(600, 200, "testdata/axis_position.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
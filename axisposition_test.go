@@ -0,0 +1,118 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestAxisPosition(t *testing.T) {
+	cmpimg.CheckPlot(ExampleAxisPosition, t, "axis_position.png")
+}
+
+func TestAxisPositionDataCanvas(t *testing.T) {
+	c := draw.NewCanvas(new(recorder.Canvas), vg.Points(300), vg.Points(200))
+	newPlot := func() *plot.Plot {
+		p := plot.New()
+		p.X.Min, p.X.Max = -1, 1
+		p.Y.Min, p.Y.Max = -1, 1
+		return p
+	}
+	edge := newPlot().DataCanvas(c)
+
+	cross := newPlot()
+	cross.X.Position = plot.AxisCross
+	cross.Y.Position = plot.AxisCross
+	got := cross.DataCanvas(c)
+	if got.Min.X >= edge.Min.X || got.Min.Y >= edge.Min.Y {
+		t.Errorf("crossing axes reserve space: got:%v edge:%v", got.Rectangle, edge.Rectangle)
+	}
+	if got.Max != edge.Max {
+		t.Errorf("unexpected top right corner for crossing axes: got:%v want:%v", got.Max, edge.Max)
+	}
+
+	mirror := newPlot()
+	mirror.X.Mirror = mirror.X.Side(true, true, false)
+	mirror.Y.Mirror = mirror.Y.Side(true, true, false)
+	got = mirror.DataCanvas(c)
+	if got.Min != edge.Min {
+		t.Errorf("unexpected bottom left corner for mirrored axes: got:%v want:%v", got.Min, edge.Min)
+	}
+	if got.Max.X >= edge.Max.X || got.Max.Y >= edge.Max.Y {
+		t.Errorf("mirrored axes reserve no space: got:%v edge:%v", got.Rectangle, edge.Rectangle)
+	}
+
+	frame := newPlot()
+	frame.Frame()
+	if f := frame.DataCanvas(c); f.Min.X >= got.Min.X || f.Max.X <= got.Max.X {
+		t.Errorf("framed plot keeps axis padding: got:%v mirror:%v", f.Rectangle, got.Rectangle)
+	}
+
+	labels := newPlot()
+	labels.X.Mirror = labels.X.Side(true, true, true)
+	labels.Y.Mirror = labels.Y.Side(true, true, true)
+	if l := labels.DataCanvas(c); l.Max.X >= got.Max.X || l.Max.Y >= got.Max.Y {
+		t.Errorf("mirrored tick labels reserve no space: got:%v frame:%v", l.Rectangle, got.Rectangle)
+	}
+}
+
+func TestAxisMirrorOnly(t *testing.T) {
+	p := plot.New()
+	p.X.Min, p.X.Max = -1, 1
+	p.Y.Min, p.Y.Max = -1, 1
+	p.HideY()
+
+	// Draw the line and the tick marks of the X axis only
+	// along the top edge of the data area.
+	p.X.Mirror = p.X.Side(true, true, false)
+	p.X.LineStyle.Width = 0
+	p.X.Tick.Length = 0
+
+	rec := new(recorder.Canvas)
+	c := draw.NewCanvas(rec, vg.Points(300), vg.Points(200))
+	p.Draw(c)
+	da := p.DataCanvas(c)
+
+	var (
+		line, ticks int
+		width       float64
+	)
+	for _, a := range rec.Actions {
+		if w, ok := a.(*recorder.SetLineWidth); ok {
+			width = float64(w.Width)
+			continue
+		}
+		s, ok := a.(*recorder.Stroke)
+		if !ok || len(s.Path) != 2 || width == 0 {
+			continue
+		}
+		p0, p1 := s.Path[0].Pos, s.Path[1].Pos
+		switch {
+		case p0 == p1:
+		case p0.Y == p1.Y && p1.X-p0.X == da.Max.X-da.Min.X:
+			line++
+			if p0.Y < da.Max.Y {
+				t.Errorf("unexpected axis line below the top of the data area at %v", p0.Y)
+			}
+		case p0.X == p1.X:
+			ticks++
+			if min(p0.Y, p1.Y) < da.Max.Y {
+				t.Errorf("unexpected tick mark below the top of the data area at %v", p0)
+			}
+		}
+	}
+	if line != 1 {
+		t.Errorf("unexpected number of axis lines: got:%d want:1", line)
+	}
+	if ticks == 0 {
+		t.Error("no mirrored tick marks drawn")
+	}
+}
@@ -0,0 +1,569 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Side is a side of the data area of a plot.
+type Side int
+
+const (
+	// SideLeft is the left side of the data area.
+	SideLeft Side = iota
+
+	// SideRight is the right side of the data area.
+	SideRight
+
+	// SideBottom is the bottom side of the data area.
+	SideBottom
+
+	// SideTop is the top side of the data area.
+	SideTop
+)
+
+// ColorBar is a color bar drawn along a side of the data
+// area of a plot, showing the mapping from data values to
+// colors.
+type ColorBar struct {
+	// ColorMap is the color map shown by the color bar.
+	// Its range is the range of the color bar when
+	// Boundaries is nil.
+	ColorMap palette.ColorMap
+
+	// Boundaries, if not nil, are the increasing boundaries
+	// of the discrete colors of the color bar, which range
+	// from the first to the last boundary. The color between
+	// Boundaries[i] and Boundaries[i+1] is Colors[i] if Colors
+	// is not nil, or the color of ColorMap at the middle of
+	// the interval otherwise. When the Axis uses DefaultTicks,
+	// the boundaries are used as tick marks.
+	Boundaries []float64
+
+	// Colors are the discrete colors of the color bar.
+	// The length of Colors must be one less than the
+	// length of Boundaries.
+	Colors []color.Color
+
+	// Norm, if not nil, is the normalization of the values
+	// of the color bar, used as the Scale of its Axis when
+	// the Axis uses a LinearScale. If Norm is nil and the
+	// ColorMap is a palette.NormalizedColorMap, its Normalizer
+	// is used. The boundaries of a palette.BoundaryNorm are
+	// used when Boundaries is nil, and the ticks of a
+	// palette.LogNorm are placed with LogTicks when the Axis
+	// uses DefaultTicks.
+	Norm palette.Normalizer
+
+	// Underflow and Overflow, if not nil, are the colors
+	// of triangular extensions drawn beyond the minimum
+	// and maximum ends of the color bar, representing
+	// values out of its range.
+	Underflow, Overflow color.Color
+
+	// Side is the side of the data area of
+	// the plot along which the color bar is drawn.
+	Side Side
+
+	// Fraction is the thickness of the color bar, as
+	// a fraction of the size of the plot canvas across
+	// the color bar.
+	Fraction float64
+
+	// Padding is the distance between the
+	// data area of the plot and the color bar.
+	Padding vg.Length
+
+	// Axis is the axis of the color bar, drawn on the
+	// outer side of the color bar. Its range is set from
+	// the ColorMap or the Boundaries when drawn, its Scale
+	// is used to place the colors and its LineStyle is used
+	// to outline the color bar. The alignment of its tick
+	// labels is set according to the Side of the color bar.
+	Axis Axis
+}
+
+// NewColorBar returns a color bar drawn on the
+// right of the data area of a plot, for the color
+// map cmap.
+func NewColorBar(cmap palette.ColorMap) *ColorBar {
+	cb := &ColorBar{
+		ColorMap: cmap,
+		Side:     SideRight,
+		Fraction: 0.05,
+		Padding:  vg.Points(5),
+		Axis:     makeAxis(vertical),
+	}
+	cb.Axis.Padding = 0
+	return cb
+}
+
+// vertical returns whether the color bar is vertical.
+func (cb *ColorBar) vertical() bool {
+	return cb.Side == SideLeft || cb.Side == SideRight
+}
+
+// norm returns the normalization of the values of the
+// color bar, or nil if it has none.
+func (cb *ColorBar) norm() palette.Normalizer {
+	if cb.Norm != nil {
+		return cb.Norm
+	}
+	if c, ok := cb.ColorMap.(palette.NormalizedColorMap); ok {
+		return c.Normalizer()
+	}
+	return nil
+}
+
+// boundaries returns the boundaries of the discrete colors
+// of the color bar, or nil if its colors are continuous.
+func (cb *ColorBar) boundaries() []float64 {
+	if len(cb.Boundaries) != 0 {
+		return cb.Boundaries
+	}
+	if bn, ok := cb.norm().(palette.BoundaryNorm); ok {
+		return bn.Boundaries
+	}
+	return nil
+}
+
+// axis returns the axis of the color bar with its range set.
+func (cb *ColorBar) axis() Axis {
+	a := cb.Axis
+	switch b := cb.boundaries(); {
+	case len(b) != 0:
+		a.Min, a.Max = b[0], b[len(b)-1]
+	case cb.ColorMap != nil:
+		a.Min, a.Max = cb.ColorMap.Min(), cb.ColorMap.Max()
+	}
+	switch norm := cb.norm(); norm := norm.(type) {
+	case nil, palette.LinearNorm:
+		// Colors are placed linearly.
+	case palette.BoundaryNorm:
+		if _, ok := a.Scale.(LinearScale); ok && len(cb.Boundaries) == 0 {
+			a.Scale = boundaryScale(norm.Boundaries)
+		}
+	default:
+		if _, ok := a.Scale.(LinearScale); ok {
+			a.Scale = norm
+		}
+		if _, ok := norm.(palette.LogNorm); ok {
+			if _, ok := a.Tick.Marker.(DefaultTicks); ok {
+				a.Tick.Marker = LogTicks{Prec: -1}
+			}
+		}
+	}
+	a.sanitizeRange()
+	return a.wrapLabel()
+}
+
+// ticks returns the tick marks of the axis a.
+func (cb *ColorBar) ticks(a Axis) []Tick {
+	b := cb.boundaries()
+	if _, ok := a.Tick.Marker.(DefaultTicks); ok && len(b) != 0 {
+		ticks := make([]Tick, len(b))
+		for i, v := range b {
+			ticks[i] = Tick{Value: v, Label: formatFloatTick(v, 3)}
+		}
+		return ticks
+	}
+	return a.Tick.Marker.Ticks(a.Min, a.Max)
+}
+
+// thickness returns the thickness of the
+// color bar drawn alongside the canvas c.
+func (cb *ColorBar) thickness(c draw.Canvas) vg.Length {
+	if cb.vertical() {
+		return vg.Length(cb.Fraction) * (c.Max.X - c.Min.X)
+	}
+	return vg.Length(cb.Fraction) * (c.Max.Y - c.Min.Y)
+}
+
+// axisSize returns the size of the axis
+// of the color bar across the color bar.
+func (cb *ColorBar) axisSize() vg.Length {
+	a := cb.axis()
+	var s vg.Length
+	if a.drawTicks() {
+		s += a.Tick.Length
+	}
+	marks := a.labels(cb.ticks(a))
+	if cb.vertical() {
+		if w := tickLabelWidth(a.Tick.Label, marks); w > 0 {
+			s += w + a.Tick.Label.Width(" ")
+		}
+	} else {
+		s += tickLabelHeight(a.Tick.Label, marks)
+	}
+	if a.Label.Text != "" {
+		s += a.Label.Padding
+		s += a.Label.TextStyle.Height(a.Label.Text)
+	}
+	return s
+}
+
+// crop returns the canvas of the plot drawn with the color bar
+// and the canvas reserved for the color bar, within c.
+func (cb *ColorBar) crop(c draw.Canvas) (plot, bar draw.Canvas) {
+	size := cb.Padding + cb.thickness(c) + cb.axisSize()
+	plot, bar = c, c
+	switch cb.Side {
+	case SideLeft:
+		plot.Min.X += size
+		bar.Max.X = plot.Min.X
+	case SideRight:
+		plot.Max.X -= size
+		bar.Min.X = plot.Max.X
+	case SideBottom:
+		plot.Min.Y += size
+		bar.Max.Y = plot.Min.Y
+	case SideTop:
+		plot.Max.Y -= size
+		bar.Min.Y = plot.Max.Y
+	default:
+		panic(fmt.Errorf("plot: invalid color bar side %d", cb.Side))
+	}
+	return plot, bar
+}
+
+// draw draws the color bar to the canvas c returned by crop,
+// alongside the data area dataC of a plot.
+func (cb *ColorBar) draw(c, dataC draw.Canvas) {
+	t := c.Max.Y - c.Min.Y
+	if cb.vertical() {
+		t = c.Max.X - c.Min.X
+	}
+	t -= cb.Padding + cb.axisSize()
+	bar := dataC.Rectangle
+	switch cb.Side {
+	case SideLeft:
+		bar.Max.X = c.Max.X - cb.Padding
+		bar.Min.X = bar.Max.X - t
+	case SideRight:
+		bar.Min.X = c.Min.X + cb.Padding
+		bar.Max.X = bar.Min.X + t
+	case SideBottom:
+		bar.Max.Y = c.Max.Y - cb.Padding
+		bar.Min.Y = bar.Max.Y - t
+	case SideTop:
+		bar.Min.Y = c.Min.Y + cb.Padding
+		bar.Max.Y = bar.Min.Y + t
+	}
+	// Leave room for the extensions.
+	if cb.vertical() {
+		t = min(t, (bar.Max.Y-bar.Min.Y)/4)
+		if cb.Underflow != nil {
+			bar.Min.Y += t
+		}
+		if cb.Overflow != nil {
+			bar.Max.Y -= t
+		}
+	} else {
+		t = min(t, (bar.Max.X-bar.Min.X)/4)
+		if cb.Underflow != nil {
+			bar.Min.X += t
+		}
+		if cb.Overflow != nil {
+			bar.Max.X -= t
+		}
+	}
+
+	a := cb.axis()
+	cb.drawColors(c, bar, a)
+	outline := cb.outline(bar, t)
+	if cb.Underflow != nil {
+		c.SetColor(cb.Underflow)
+		c.Fill(cb.extension(bar, t, false))
+	}
+	if cb.Overflow != nil {
+		c.SetColor(cb.Overflow)
+		c.Fill(cb.extension(bar, t, true))
+	}
+	if a.Width > 0 {
+		c.SetLineStyle(a.LineStyle)
+		c.Stroke(outline)
+	}
+	cb.drawAxis(c, bar, a)
+}
+
+// at returns the point along the color bar
+// at the normalized position x.
+func (cb *ColorBar) at(bar vg.Rectangle, x float64) vg.Length {
+	if cb.vertical() {
+		return bar.Min.Y + vg.Length(x)*(bar.Max.Y-bar.Min.Y)
+	}
+	return bar.Min.X + vg.Length(x)*(bar.Max.X-bar.Min.X)
+}
+
+// slice returns the part of the color
+// bar between normalized positions x0 and x1.
+func (cb *ColorBar) slice(bar vg.Rectangle, x0, x1 float64) vg.Rectangle {
+	r := bar
+	if cb.vertical() {
+		r.Min.Y, r.Max.Y = cb.at(bar, x0), cb.at(bar, x1)
+	} else {
+		r.Min.X, r.Max.X = cb.at(bar, x0), cb.at(bar, x1)
+	}
+	return r
+}
+
+// drawColors fills the color bar with its colors.
+func (cb *ColorBar) drawColors(c draw.Canvas, bar vg.Rectangle, a Axis) {
+	if b := cb.boundaries(); len(b) != 0 {
+		if cb.Colors != nil && len(cb.Colors) != len(b)-1 {
+			panic(fmt.Errorf("plot: color bar colors length (%d) != boundaries length (%d) - 1", len(cb.Colors), len(b)))
+		}
+		for i := range len(b) - 1 {
+			lo, hi := b[i], b[i+1]
+			var col color.Color
+			if cb.Colors != nil {
+				col = cb.Colors[i]
+			} else {
+				col = cb.color((lo + hi) / 2)
+			}
+			if col == nil {
+				continue
+			}
+			c.SetColor(col)
+			c.Fill(cb.slice(bar, a.Norm(lo), a.Norm(hi)).Path())
+		}
+		return
+	}
+	if cb.ColorMap == nil {
+		return
+	}
+
+	// Use one pixel per point along the color bar.
+	n := int(bar.Max.Y - bar.Min.Y)
+	if !cb.vertical() {
+		n = int(bar.Max.X - bar.Min.X)
+	}
+	n = max(n, 1)
+	var img *image.NRGBA64
+	if cb.vertical() {
+		img = image.NewNRGBA64(image.Rect(0, 0, 1, n))
+	} else {
+		img = image.NewNRGBA64(image.Rect(0, 0, n, 1))
+	}
+	for i := range n {
+		col := cb.color(a.invert((float64(i) + 0.5) / float64(n)))
+		if col == nil {
+			continue
+		}
+		if cb.vertical() {
+			img.Set(0, n-1-i, col)
+		} else {
+			img.Set(i, 0, col)
+		}
+	}
+	c.DrawImage(bar, img)
+}
+
+// color returns the color of the ColorMap at v,
+// or nil if it has no color at v.
+func (cb *ColorBar) color(v float64) color.Color {
+	if cb.ColorMap == nil {
+		return nil
+	}
+	cmin, cmax := cb.ColorMap.Min(), cb.ColorMap.Max()
+	col, err := cb.ColorMap.At(math.Max(cmin, math.Min(v, cmax)))
+	if err != nil {
+		return nil
+	}
+	return col
+}
+
+// extension returns the triangular extension beyond the
+// maximum end of the color bar if max is true, or beyond
+// its minimum end otherwise, with length t.
+func (cb *ColorBar) extension(bar vg.Rectangle, t vg.Length, max bool) vg.Path {
+	var p vg.Path
+	if cb.vertical() {
+		y, apex := bar.Min.Y, bar.Min.Y-t
+		if max {
+			y, apex = bar.Max.Y, bar.Max.Y+t
+		}
+		p.Move(vg.Point{X: bar.Min.X, Y: y})
+		p.Line(vg.Point{X: (bar.Min.X + bar.Max.X) / 2, Y: apex})
+		p.Line(vg.Point{X: bar.Max.X, Y: y})
+	} else {
+		x, apex := bar.Min.X, bar.Min.X-t
+		if max {
+			x, apex = bar.Max.X, bar.Max.X+t
+		}
+		p.Move(vg.Point{X: x, Y: bar.Min.Y})
+		p.Line(vg.Point{X: apex, Y: (bar.Min.Y + bar.Max.Y) / 2})
+		p.Line(vg.Point{X: x, Y: bar.Max.Y})
+	}
+	p.Close()
+	return p
+}
+
+// outline returns the outline of the color bar,
+// including its extensions of length t.
+func (cb *ColorBar) outline(bar vg.Rectangle, t vg.Length) vg.Path {
+	var p vg.Path
+	if cb.vertical() {
+		cx := (bar.Min.X + bar.Max.X) / 2
+		p.Move(vg.Point{X: bar.Min.X, Y: bar.Min.Y})
+		if cb.Underflow != nil {
+			p.Line(vg.Point{X: cx, Y: bar.Min.Y - t})
+		}
+		p.Line(vg.Point{X: bar.Max.X, Y: bar.Min.Y})
+		p.Line(vg.Point{X: bar.Max.X, Y: bar.Max.Y})
+		if cb.Overflow != nil {
+			p.Line(vg.Point{X: cx, Y: bar.Max.Y + t})
+		}
+		p.Line(vg.Point{X: bar.Min.X, Y: bar.Max.Y})
+	} else {
+		cy := (bar.Min.Y + bar.Max.Y) / 2
+		p.Move(vg.Point{X: bar.Min.X, Y: bar.Min.Y})
+		p.Line(vg.Point{X: bar.Max.X, Y: bar.Min.Y})
+		if cb.Overflow != nil {
+			p.Line(vg.Point{X: bar.Max.X + t, Y: cy})
+		}
+		p.Line(vg.Point{X: bar.Max.X, Y: bar.Max.Y})
+		p.Line(vg.Point{X: bar.Min.X, Y: bar.Max.Y})
+		if cb.Underflow != nil {
+			p.Line(vg.Point{X: bar.Min.X - t, Y: cy})
+		}
+	}
+	p.Close()
+	return p
+}
+
+// drawAxis draws the tick marks, tick labels and label of the
+// axis a on the outer side of the color bar.
+func (cb *ColorBar) drawAxis(c draw.Canvas, bar vg.Rectangle, a Axis) {
+	// dir is the direction away from the color
+	// bar and edge the outer edge of the color bar.
+	var (
+		dir  vg.Length = 1
+		edge vg.Length
+		sty  = a.Tick.Label
+	)
+	switch cb.Side {
+	case SideLeft:
+		dir, edge = -1, bar.Min.X
+		sty.XAlign, sty.YAlign = draw.XRight, draw.YCenter
+	case SideRight:
+		edge = bar.Max.X
+		sty.XAlign, sty.YAlign = draw.XLeft, draw.YCenter
+	case SideBottom:
+		dir, edge = -1, bar.Min.Y
+		sty.XAlign, sty.YAlign = draw.XCenter, draw.YTop
+	case SideTop:
+		edge = bar.Max.Y
+		sty.XAlign, sty.YAlign = draw.XCenter, draw.YBottom
+	}
+	point := func(along, across vg.Length) vg.Point {
+		if cb.vertical() {
+			return vg.Point{X: across, Y: along}
+		}
+		return vg.Point{X: along, Y: across}
+	}
+
+	marks := cb.ticks(a)
+	off := edge
+	if a.drawTicks() {
+		for _, t := range marks {
+			x := a.Norm(t.Value)
+			if x < 0 || x > 1 {
+				continue
+			}
+			pos := cb.at(bar, x)
+			start := edge + dir*t.lengthOffset(a.Tick.Length)
+			c.StrokeLines(a.Tick.LineStyle, []vg.Point{
+				point(pos, start),
+				point(pos, edge+dir*a.Tick.Length),
+			})
+		}
+		off += dir * a.Tick.Length
+	}
+
+	labels := a.labels(marks)
+	if cb.vertical() {
+		if w := tickLabelWidth(sty, labels); w > 0 {
+			off += dir * sty.Width(" ")
+			for _, t := range labels {
+				x := a.Norm(t.Value)
+				if t.IsMinor() || x < 0 || x > 1 {
+					continue
+				}
+				c.FillText(sty, point(cb.at(bar, x), off), t.Label)
+			}
+			off += dir * w
+		}
+	} else {
+		for _, t := range labels {
+			x := a.Norm(t.Value)
+			if t.IsMinor() || x < 0 || x > 1 {
+				continue
+			}
+			c.FillText(sty, point(cb.at(bar, x), off), t.Label)
+		}
+		off += dir * tickLabelHeight(sty, labels)
+	}
+
+	if a.Label.Text == "" {
+		return
+	}
+	off += dir * a.Label.Padding
+	lsty := a.Label.TextStyle
+	lsty.XAlign = draw.XCenter
+	// Align the side of the label facing the color bar.
+	// The bottom of the rotated label of a vertical
+	// color bar faces right.
+	lsty.YAlign = draw.YTop
+	if (dir > 0) != cb.vertical() {
+		lsty.YAlign = draw.YBottom
+	}
+	if cb.vertical() {
+		lsty.Rotation += math.Pi / 2
+	}
+	along := cb.at(bar, 0.5)
+	c.FillText(lsty, point(along, off), a.Label.Text)
+}
+
+// boundaryScale is a Normalizer placing the intervals
+// between its increasing boundaries evenly, mapping
+// values linearly within each interval.
+type boundaryScale []float64
+
+// Normalize returns the position of x between the
+// first and the last boundaries.
+func (b boundaryScale) Normalize(_, _, x float64) float64 {
+	n := len(b) - 1
+	i := sort.SearchFloat64s(b, x) - 1
+	i = max(0, min(i, n-1))
+	return (float64(i) + (x-b[i])/(b[i+1]-b[i])) / float64(n)
+}
+
+// invert returns the value of the axis
+// at the normalized position x.
+func (a Axis) invert(x float64) float64 {
+	lo, hi := a.Min, a.Max
+	if a.Norm(lo) > a.Norm(hi) {
+		lo, hi = hi, lo
+	}
+	for range 64 {
+		mid := (lo + hi) / 2
+		if a.Norm(mid) < x {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
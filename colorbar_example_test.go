@@ -0,0 +1,69 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// ExampleColorBar draws plots with color bars attached to
+// their data areas, using a logarithmic scale on the right
+// and a linear scale at the bottom.
+func ExampleColorBar() {
+	cmap := moreland.ExtendedBlackBody()
+	cmap.SetMin(1)
+	cmap.SetMax(1000)
+
+	pts := make(plotter.XYs, 40)
+	for i := range pts {
+		pts[i].X = float64(i)
+		pts[i].Y = math.Pow(10, 3*float64(i)/float64(len(pts)-1))
+	}
+	newPlot := func(title string) *plot.Plot {
+		s, err := plotter.NewScatter(pts)
+		if err != nil {
+			log.Panic(err)
+		}
+		s.GlyphStyleFunc = func(i int) draw.GlyphStyle {
+			sty := s.GlyphStyle
+			sty.Shape = draw.CircleGlyph{}
+			sty.Color, _ = cmap.At(pts[i].Y)
+			return sty
+		}
+		p := plot.New()
+		p.Title.Text = title
+		p.Y.Scale = plot.LogScale{}
+		p.Y.Tick.Marker = plot.LogTicks{Prec: -1}
+		p.Add(s)
+		return p
+	}
+
+	lg := newPlot("Logarithmic")
+	lg.ColorBar = plot.NewColorBar(cmap)
+	lg.ColorBar.Axis.Scale = plot.LogScale{}
+	lg.ColorBar.Axis.Tick.Marker = plot.LogTicks{Prec: -1}
+	lg.ColorBar.Axis.Label.Text = "Y"
+
+	lin := newPlot("Linear")
+	lin.ColorBar = plot.NewColorBar(cmap)
+	lin.ColorBar.Side = plot.SideBottom
+	lin.ColorBar.Fraction = 0.08
+	lin.ColorBar.Overflow = cmap.Palette(2).Colors()[1]
+
+	fig := plot.NewFigure(1, 2)
+	fig.Add(lg, 0, 0)
+	fig.Add(lin, 0, 1)
+
+	err := fig.Save(400, 200, "testdata/colorbar.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestColorBar(t *testing.T) {
+	cmpimg.CheckPlot(ExampleColorBar, t, "colorbar.png")
+}
+
+func TestColorBarDataCanvas(t *testing.T) {
+	c := draw.NewCanvas(new(recorder.Canvas), vg.Points(300), vg.Points(200))
+
+	p := plot.New()
+	want := p.DataCanvas(c)
+
+	cmap := moreland.SmoothBlueRed()
+	cmap.SetMax(1)
+	for _, side := range []plot.Side{plot.SideLeft, plot.SideRight, plot.SideBottom, plot.SideTop} {
+		p.ColorBar = plot.NewColorBar(cmap)
+		p.ColorBar.Side = side
+		got := p.DataCanvas(c)
+
+		var shrunk bool
+		switch side {
+		case plot.SideLeft:
+			shrunk = got.Min.X > want.Min.X && got.Max.X == want.Max.X
+		case plot.SideRight:
+			shrunk = got.Max.X < want.Max.X && got.Min.X == want.Min.X
+		case plot.SideBottom:
+			shrunk = got.Min.Y > want.Min.Y && got.Max.Y == want.Max.Y
+		case plot.SideTop:
+			shrunk = got.Max.Y < want.Max.Y && got.Min.Y == want.Min.Y
+		}
+		if !shrunk {
+			t.Errorf("unexpected data canvas for side %d: got:%v plot without color bar:%v", side, got.Rectangle, want.Rectangle)
+		}
+	}
+}
+
+func TestColorBarNorm(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		norm   palette.Normalizer
+		labels []string
+	}{
+		{
+			name:   "log",
+			norm:   palette.LogNorm{},
+			labels: []string{"1", "10", "100", "1000"},
+		},
+		{
+			name:   "boundary",
+			norm:   palette.BoundaryNorm{Boundaries: []float64{1, 2, 5, 10, 100, 1000}},
+			labels: []string{"1", "2", "5", "10", "100", "1e+03"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			cmap := moreland.SmoothBlueRed()
+			cmap.SetMin(1)
+			cmap.SetMax(1000)
+
+			rec := new(recorder.Canvas)
+			c := draw.NewCanvas(rec, vg.Points(300), vg.Points(200))
+			p := plot.New()
+			p.ColorBar = plot.NewColorBar(palette.Normalize(cmap, test.norm))
+			dataC := p.DataCanvas(c)
+			p.Draw(c)
+
+			// The tick labels of the color bar
+			// are evenly spaced along it.
+			var ys []vg.Length
+			for _, a := range rec.Actions {
+				s, ok := a.(*recorder.FillString)
+				if !ok || s.Point.X < dataC.Max.X {
+					continue
+				}
+				if len(ys) == len(test.labels) || s.String != test.labels[len(ys)] {
+					t.Fatalf("unexpected tick label %q", s.String)
+				}
+				ys = append(ys, s.Point.Y)
+			}
+			if len(ys) != len(test.labels) {
+				t.Fatalf("unexpected number of tick labels: got=%d, want=%d", len(ys), len(test.labels))
+			}
+			step := ys[1] - ys[0]
+			for i := 2; i < len(ys); i++ {
+				if d := ys[i] - ys[i-1]; math.Abs(float64(d-step)) > 1e-6 {
+					t.Errorf("uneven tick label spacing: %v != %v", d, step)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"image/color"
+	"io"
+	"math"
+
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/text"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Figure is a set of plots laid out in a grid, with a title,
+// a subtitle, a legend and a color bar shared by all the plots.
+type Figure struct {
+	Title struct {
+		// Text is the text of the figure title. If
+		// Text is the empty string then the figure
+		// will not have a title.
+		Text string
+
+		// Padding is the amount of padding
+		// between the bottom of the title and
+		// the top of the subtitle or plots.
+		Padding vg.Length
+
+		// TextStyle specifies how the figure title text should be displayed.
+		TextStyle text.Style
+	}
+
+	Subtitle struct {
+		// Text is the text of the figure subtitle. If
+		// Text is the empty string then the figure
+		// will not have a subtitle.
+		Text string
+
+		// Padding is the amount of padding
+		// between the bottom of the subtitle and
+		// the top of the plots.
+		Padding vg.Length
+
+		// TextStyle specifies how the figure subtitle text should be displayed.
+		TextStyle text.Style
+	}
+
+	// BackgroundColor is the background color of the figure.
+	// The default is White.
+	BackgroundColor color.Color
+
+	// Grid is the layout of the plots of the figure.
+	Grid Grid
+
+	// Cells are the plots of the figure
+	// and their location in the Grid.
+	Cells []GridCell
+
+	// ShareX and ShareY specify how the X and Y axes of
+	// the plots are shared when the figure is drawn.
+	// See ShareAxes for details. The axes are shared
+	// among copies of the plots, which are not modified.
+	// Plots spanning several rows or columns share their
+	// axes with the plots of all of them.
+	ShareX, ShareY Sharing
+
+	// Legend is the legend of the figure, drawn
+	// along the right edge of the figure.
+	Legend Legend
+
+	// MergeLegends specifies whether the entries of the
+	// legends of the plots are merged into the Legend of
+	// the figure when it is drawn, instead of being drawn
+	// with each plot. Entries with the same text are only
+	// added once.
+	MergeLegends bool
+
+	// ColorBar, if not nil, is a color bar drawn along
+	// the side of the plots of the figure given by its
+	// Side, between the plots and the legend. It spans
+	// the data areas of the plots, and its Fraction is
+	// a fraction of the size of the figure.
+	ColorBar *ColorBar
+
+	// Padding is the amount of padding between
+	// the plots and the legend.
+	Padding vg.Length
+}
+
+// NewFigure returns a new figure with a grid of the given
+// number of rows and columns, and some reasonable default
+// settings. The entries of the legends of its plots are
+// merged into the legend of the figure.
+func NewFigure(rows, cols int) *Figure {
+	hdlr := DefaultTextHandler
+	f := &Figure{
+		BackgroundColor: color.White,
+		Grid: Grid{
+			Tiles: draw.Tiles{
+				Rows:      rows,
+				Cols:      cols,
+				PadX:      vg.Millimeter,
+				PadY:      vg.Millimeter,
+				PadTop:    vg.Points(2),
+				PadBottom: vg.Points(2),
+				PadLeft:   vg.Points(2),
+				PadRight:  vg.Points(2),
+			},
+		},
+		Legend:       newLegend(hdlr),
+		MergeLegends: true,
+		Padding:      vg.Points(5),
+	}
+	f.Legend.Top = true
+	f.Title.TextStyle = text.Style{
+		Color:   color.Black,
+		Font:    font.From(DefaultFont, 14),
+		XAlign:  draw.XCenter,
+		YAlign:  draw.YTop,
+		Handler: hdlr,
+	}
+	f.Subtitle.TextStyle = text.Style{
+		Color:   color.Black,
+		Font:    font.From(DefaultFont, 10),
+		XAlign:  draw.XCenter,
+		YAlign:  draw.YTop,
+		Handler: hdlr,
+	}
+	return f
+}
+
+// Add adds the plot p to the figure, at the cell of
+// the grid at the given row and column.
+func (f *Figure) Add(p *Plot, row, col int) {
+	f.Cells = append(f.Cells, GridCell{Plot: p, Row: row, Col: col})
+}
+
+// Draw draws the figure to a draw.Canvas.
+func (f *Figure) Draw(c draw.Canvas) {
+	if f.BackgroundColor != nil {
+		c.SetColor(f.BackgroundColor)
+		c.Fill(c.Rectangle.Path())
+	}
+
+	if f.Title.Text != "" {
+		descent := f.Title.TextStyle.FontExtents().Descent
+		c.FillText(f.Title.TextStyle, vg.Point{X: c.Center().X, Y: c.Max.Y + descent}, f.Title.Text)
+
+		rect := f.Title.TextStyle.Rectangle(f.Title.Text)
+		c.Max.Y -= rect.Size().Y
+		c.Max.Y -= f.Title.Padding
+	}
+	if f.Subtitle.Text != "" {
+		descent := f.Subtitle.TextStyle.FontExtents().Descent
+		c.FillText(f.Subtitle.TextStyle, vg.Point{X: c.Center().X, Y: c.Max.Y + descent}, f.Subtitle.Text)
+
+		rect := f.Subtitle.TextStyle.Rectangle(f.Subtitle.Text)
+		c.Max.Y -= rect.Size().Y
+		c.Max.Y -= f.Subtitle.Padding
+	}
+
+	cells := f.cells()
+
+	legend := f.legend()
+	if len(legend.entries) != 0 {
+		r := legend.Rectangle(c)
+		w := r.Max.X - r.Min.X
+		legend.Draw(draw.Crop(c, c.Max.X-c.Min.X-w, 0, 0, 0))
+		c.Max.X -= w + f.Padding
+	}
+
+	var bar draw.Canvas
+	if f.ColorBar != nil {
+		c, bar = f.ColorBar.crop(c)
+	}
+
+	cs := AlignGrid(cells, f.Grid, c)
+	for i, cell := range cells {
+		if cell.Plot != nil {
+			cell.Plot.Draw(cs[i])
+		}
+	}
+
+	if f.ColorBar != nil {
+		f.ColorBar.draw(bar, dataArea(bar, cells, cs))
+	}
+}
+
+// cells returns the cells of the figure holding copies of
+// its plots, whose axes are shared according to ShareX and
+// ShareY, so that the plots of the figure are not modified
+// when it is drawn.
+func (f *Figure) cells() []GridCell {
+	checkCells(f.Cells, f.Grid)
+	cells := append([]GridCell(nil), f.Cells...)
+	plots := make([][]*Plot, f.Grid.Rows)
+	for j := range plots {
+		plots[j] = make([]*Plot, f.Grid.Cols)
+	}
+	for i, cell := range cells {
+		if cell.Plot == nil {
+			continue
+		}
+		p := *cell.Plot
+		if f.MergeLegends {
+			p.Legend.entries = nil
+		}
+		cells[i].Plot = &p
+		r0, r1, c0, c1 := cell.span()
+		for r := r0; r <= r1; r++ {
+			for c := c0; c <= c1; c++ {
+				plots[r][c] = &p
+			}
+		}
+	}
+	if f.ShareX != ShareNone || f.ShareY != ShareNone {
+		ShareAxes(plots, f.ShareX, f.ShareY)
+	}
+	return cells
+}
+
+// legend returns the legend of the figure, with the
+// entries of the plots if MergeLegends is true.
+func (f *Figure) legend() Legend {
+	l := f.Legend
+	if !f.MergeLegends {
+		return l
+	}
+	l.entries = append([]legendEntry(nil), l.entries...)
+	seen := make(map[string]bool)
+	for _, e := range l.entries {
+		seen[e.text] = true
+	}
+	for _, cell := range f.Cells {
+		if cell.Plot == nil {
+			continue
+		}
+		for _, e := range cell.Plot.Legend.entries {
+			if seen[e.text] {
+				continue
+			}
+			seen[e.text] = true
+			l.entries = append(l.entries, e)
+		}
+	}
+	return l
+}
+
+// dataArea returns the canvas c resized to the bounding
+// box of the data areas of the plots of cells drawn to cs,
+// or c if the cells have no plot.
+func dataArea(c draw.Canvas, cells []GridCell, cs []draw.Canvas) draw.Canvas {
+	r := vg.Rectangle{
+		Min: vg.Point{X: vg.Length(math.Inf(+1)), Y: vg.Length(math.Inf(+1))},
+		Max: vg.Point{X: vg.Length(math.Inf(-1)), Y: vg.Length(math.Inf(-1))},
+	}
+	for i, cell := range cells {
+		if cell.Plot == nil {
+			continue
+		}
+		dataC := cell.Plot.DataCanvas(cs[i])
+		r.Min.X = min(r.Min.X, dataC.Min.X)
+		r.Min.Y = min(r.Min.Y, dataC.Min.Y)
+		r.Max.X = max(r.Max.X, dataC.Max.X)
+		r.Max.Y = max(r.Max.Y, dataC.Max.Y)
+	}
+	if r.Min.X > r.Max.X {
+		return c
+	}
+	c.Rectangle = r
+	return c
+}
+
+// WriterTo returns an io.WriterTo that will write the figure as
+// the specified image format.
+//
+// Supported formats are:
+//
+//   - .eps
+//   - .jpg|.jpeg
+//   - .pdf
+//   - .png
+//   - .svg
+//   - .tex
+//   - .tif|.tiff
+func (f *Figure) WriterTo(w, h vg.Length, format string) (io.WriterTo, error) {
+	c, err := draw.NewFormattedCanvas(w, h, format)
+	if err != nil {
+		return nil, err
+	}
+	f.Draw(draw.New(c))
+	return c, nil
+}
+
+// Save saves the figure to an image file. The file format is determined
+// by the extension.
+//
+// Supported extensions are:
+//
+//   - .eps
+//   - .jpg|.jpeg
+//   - .pdf
+//   - .png
+//   - .svg
+//   - .tex
+//   - .tif|.tiff
+func (f *Figure) Save(w, h vg.Length, file string) error {
+	return save(file, func(format string) (io.WriterTo, error) {
+		return f.WriterTo(w, h, format)
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// ExampleFigure draws a figure with a title, a subtitle, a legend
+// merged from the legends of its plots and a shared color bar.
+func ExampleFigure() {
+	cmap := moreland.SmoothBlueRed()
+	cmap.SetMin(-1)
+	cmap.SetMax(+1)
+
+	fig := plot.NewFigure(2, 2)
+	fig.Title.Text = "Waves"
+	fig.Subtitle.Text = "Sine and cosine at increasing frequencies"
+	fig.ShareX = plot.ShareAll
+	fig.ShareY = plot.ShareAll
+
+	for k := range 4 {
+		freq := float64(k + 1)
+		sin := plotter.NewFunction(func(x float64) float64 { return math.Sin(freq * x) })
+		sin.Color = color.RGBA{R: 255, A: 255}
+		cos := plotter.NewFunction(func(x float64) float64 { return math.Cos(freq * x) })
+		cos.Color = color.RGBA{B: 255, A: 255}
+		cos.Dashes = []vg.Length{vg.Points(2), vg.Points(2)}
+
+		pts := make(plotter.XYs, 16)
+		for i := range pts {
+			pts[i].X = float64(i) * 2 * math.Pi / float64(len(pts)-1)
+			pts[i].Y = math.Sin(freq*pts[i].X) * math.Cos(pts[i].X)
+		}
+		s, err := plotter.NewScatter(pts)
+		if err != nil {
+			log.Panic(err)
+		}
+		s.GlyphStyleFunc = func(i int) draw.GlyphStyle {
+			sty := s.GlyphStyle
+			sty.Shape = draw.CircleGlyph{}
+			sty.Color, _ = cmap.At(pts[i].Y)
+			return sty
+		}
+
+		p := plot.New()
+		p.Title.Text = fmt.Sprintf("f = %v", freq)
+		p.X.Label.Text = "x"
+		p.Y.Label.Text = "y"
+		p.X.Min, p.X.Max = 0, 2*math.Pi
+		p.Y.Min, p.Y.Max = -1, 1
+		p.Add(sin, cos, s)
+		p.Legend.Add("sin", sin)
+		p.Legend.Add("cos", cos)
+		p.Legend.Add("product", s)
+		fig.Add(p, k/2, k%2)
+	}
+
+	fig.ColorBar = plot.NewColorBar(cmap)
+	fig.ColorBar.Fraction = 0.025
+
+	err := fig.Save(400, 300, "testdata/figure.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
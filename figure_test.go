@@ -0,0 +1,67 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestFigure(t *testing.T) {
+	cmpimg.CheckPlot(ExampleFigure, t, "figure.png")
+}
+
+func TestFigureShare(t *testing.T) {
+	fig := plot.NewFigure(2, 1)
+	fig.ShareX = plot.ShareAll
+	for i := range 2 {
+		p := plot.New()
+		p.X.Label.Text = "x"
+		l, err := plotter.NewLine(plotter.XYs{{X: 0, Y: 0}, {X: float64(i + 1), Y: 1}})
+		if err != nil {
+			t.Fatalf("could not create line: %+v", err)
+		}
+		p.Add(l)
+		p.Legend.Add("line", l)
+		fig.Add(p, i, 0)
+	}
+
+	c := draw.NewCanvas(new(recorder.Canvas), vg.Points(200), vg.Points(200))
+	fig.Draw(c)
+
+	for i, cell := range fig.Cells {
+		p := cell.Plot
+		if got, want := p.X.Max, float64(i+1); got != want {
+			t.Errorf("unexpected X.Max of plot %d after drawing: got:%v want:%v", i, got, want)
+		}
+		if p.X.Tick.HideLabels || p.X.Label.Hide || p.X.Label.Text != "x" {
+			t.Errorf("unexpected hidden X labels of plot %d after drawing", i)
+		}
+		if r := p.Legend.Rectangle(c); r.Size().Y == 0 {
+			t.Errorf("unexpected empty legend of plot %d after drawing", i)
+		}
+	}
+}
+
+func TestFigureCellBounds(t *testing.T) {
+	fig := plot.NewFigure(1, 1)
+	fig.ShareX = plot.ShareAll
+	fig.Add(plot.New(), 0, 0)
+	fig.Add(plot.New(), 1, 0)
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatalf("expected a panic for a cell out of the grid")
+		}
+	}()
+	fig.Draw(draw.NewCanvas(new(recorder.Canvas), vg.Points(200), vg.Points(200)))
+}
@@ -179,14 +179,58 @@ func (f *Face) Width(s string) Length {
 	return Points(float64(width)) * scale
 }
 
+// Kern returns the kerning between the glyphs of the runes prev
+// and next, when drawn one after the other using the font. It is
+// zero when the font has no glyph for one of the runes.
+func (f *Face) Kern(prev, next rune) Length {
+	var (
+		pixelsPerEm = fixed.Int26_6(f.Face.UnitsPerEm())
+		scale       = f.Font.Size / Points(float64(pixelsPerEm))
+		buf         sfnt.Buffer
+	)
+	i0, err := f.Face.GlyphIndex(&buf, prev)
+	if err != nil || i0 == 0 {
+		return 0
+	}
+	i1, err := f.Face.GlyphIndex(&buf, next)
+	if err != nil || i1 == 0 {
+		return 0
+	}
+	kern, err := f.Face.Kern(&buf, i0, i1, pixelsPerEm, defaultHinting)
+	switch {
+	case err == nil:
+		return Points(float64(kern)) * scale
+	case errors.Is(err, sfnt.ErrNotFound):
+		return 0
+	default:
+		panic(fmt.Errorf("could not get kerning: %v", err))
+	}
+}
+
 // Collection is a collection of fonts, regrouped under a common typeface.
 type Collection []Face
 
+// has returns whether the font face has a glyph for the rune.
+func (f *Face) has(buf *sfnt.Buffer, r rune) bool {
+	idx, err := f.Face.GlyphIndex(buf, r)
+	return err == nil && idx != 0
+}
+
+// Run is a run of text drawn with a single font face.
+type Run struct {
+	Face Face
+	Text string
+}
+
 // Cache collects font faces.
 type Cache struct {
 	mu    sync.RWMutex
 	def   Typeface
 	faces map[Font]*opentype.Font
+
+	// fallbacks are the descriptors of the first font
+	// faces of the fallback collections, in order.
+	fallbacks []Font
 }
 
 // We make Cache implement dummy GobDecoder and GobEncoder interfaces
@@ -238,6 +282,96 @@ func (c *Cache) Add(coll Collection) {
 	}
 }
 
+// AddFallback adds a collection of font Faces to the font cache, as a
+// fallback for the runes missing from the font Faces looked up in the
+// cache. Fallback collections are searched in the order they were
+// added, when text is split into runs with Runs.
+func (c *Cache) AddFallback(coll Collection) {
+	if len(coll) == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.faces == nil {
+		c.faces = make(map[Font]*opentype.Font, len(coll))
+	}
+	for _, f := range coll {
+		fnt := f.Font
+		fnt.Size = 0
+		c.faces[fnt] = f.Face
+	}
+	fnt := coll[0].Font
+	fnt.Size = 0
+	c.fallbacks = append(c.fallbacks, fnt)
+}
+
+// Runs splits the text into runs of runes drawn with the same font Face,
+// with the provided font size set. Runes are drawn with the font Face
+// returned by Lookup, or, if it has no glyph for them, with the font Face
+// of the first fallback collection having one, selected with the Variant,
+// Style and Weight of the Font descriptor if available. Runes missing
+// from all the font Faces are drawn with the font Face returned by Lookup.
+func (c *Cache) Runs(fnt Font, size Length, txt string) []Run {
+	if txt == "" {
+		return nil
+	}
+	face := c.Lookup(fnt, size)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if len(c.fallbacks) == 0 || face.Face == nil {
+		return []Run{{Face: face, Text: txt}}
+	}
+
+	var (
+		buf   sfnt.Buffer
+		runs  []Run
+		start int
+		cur   = face
+	)
+	for i, r := range txt {
+		f := face
+		if !face.has(&buf, r) {
+			f = c.fallback(fnt, size, r, &buf, face)
+		}
+		if f.Face == cur.Face && f.Font == cur.Font {
+			continue
+		}
+		if i > start {
+			runs = append(runs, Run{Face: cur, Text: txt[start:i]})
+		}
+		start, cur = i, f
+	}
+	return append(runs, Run{Face: cur, Text: txt[start:]})
+}
+
+// fallback returns the font Face of the first fallback collection
+// having a glyph for the rune r, or def if there is none.
+func (c *Cache) fallback(fnt Font, size Length, r rune, buf *sfnt.Buffer, def Face) Face {
+	for _, fb := range c.fallbacks {
+		key := fnt
+		key.Typeface = fb.Typeface
+		face := c.lookup(key)
+		if face == nil {
+			key = fb
+			key.Style = fnt.Style
+			key.Weight = fnt.Weight
+			face = c.lookup(key)
+		}
+		if face == nil {
+			continue
+		}
+		f := Face{Font: key, Face: face}
+		f.Font.Size = size
+		if f.has(buf, r) {
+			return f
+		}
+	}
+	return def
+}
+
 // Lookup returns the font Face corresponding to the provided Font descriptor,
 // with the provided font size set.
 //
@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	stdfnt "golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
 	"golang.org/x/image/font/sfnt"
 	"golang.org/x/image/math/fixed"
 	"gonum.org/v1/plot/font"
@@ -213,6 +215,29 @@ func TestFontKern(t *testing.T) {
 	}
 }
 
+func TestFaceKern(t *testing.T) {
+	cache := font.NewCache(liberation.Collection())
+	fnt := cache.Lookup(font.Font{Typeface: "Liberation", Variant: "Serif"}, 12)
+
+	for _, tc := range []struct {
+		txt  string
+		want font.Length
+	}{
+		{"AV", -264 * 12.0 / 2048},
+		{"VA", -264 * 12.0 / 2048},
+		{"AA", 0},
+		{"A∇", 0},
+		{"A⁰", 0}, // Liberation has no glyph for superscript zero.
+	} {
+		t.Run(tc.txt, func(t *testing.T) {
+			rs := []rune(tc.txt)
+			if got, want := fnt.Kern(rs[0], rs[1]), tc.want; got != want {
+				t.Errorf("invalid kerning: got=%v, want=%v", got, want)
+			}
+		})
+	}
+}
+
 func TestFontName(t *testing.T) {
 	for _, tc := range []struct {
 		font *font.Font
@@ -297,3 +322,76 @@ func TestFontName(t *testing.T) {
 		}
 	}
 }
+
+func TestCacheRuns(t *testing.T) {
+	goreg, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		t.Fatalf("could not parse Go font: %+v", err)
+	}
+	gofnt := font.Collection{{Font: font.Font{Typeface: "Go"}, Face: goreg}}
+
+	fnt := font.Font{Typeface: "Liberation", Variant: "Serif"}
+	cache := font.NewCache(liberation.Collection())
+	if got := cache.Runs(fnt, 12, ""); got != nil {
+		t.Errorf("invalid runs of empty text: got=%v, want=nil", got)
+	}
+	if got := cache.Runs(fnt, 12, "x⁰y"); len(got) != 1 || got[0].Text != "x⁰y" {
+		t.Errorf("invalid runs without fallback: got=%d runs", len(got))
+	}
+
+	cache.AddFallback(gofnt)
+	if got, want := cache.Lookup(font.Font{}, 12).Font.Typeface, font.Typeface("Liberation"); got != want {
+		t.Errorf("invalid default typeface: got=%q, want=%q", got, want)
+	}
+
+	for _, tc := range []struct {
+		txt  string
+		want []string
+		tf   []font.Typeface
+	}{
+		{
+			txt:  "xy",
+			want: []string{"xy"},
+			tf:   []font.Typeface{"Liberation"},
+		},
+		{
+			txt:  "x⁰y",
+			want: []string{"x", "⁰", "y"},
+			tf:   []font.Typeface{"Liberation", "Go", "Liberation"},
+		},
+		{
+			txt:  "10⁻⁹ s",
+			want: []string{"10", "⁻⁹", " s"},
+			tf:   []font.Typeface{"Liberation", "Go", "Liberation"},
+		},
+		{
+			// Runes missing from all the fonts stay with the primary font.
+			txt:  "a★",
+			want: []string{"a★"},
+			tf:   []font.Typeface{"Liberation"},
+		},
+	} {
+		t.Run(tc.txt, func(t *testing.T) {
+			runs := cache.Runs(fnt, 12, tc.txt)
+			if len(runs) != len(tc.want) {
+				t.Fatalf("invalid number of runs: got=%d, want=%d", len(runs), len(tc.want))
+			}
+			var width font.Length
+			for i, run := range runs {
+				if run.Text != tc.want[i] {
+					t.Errorf("invalid run %d text: got=%q, want=%q", i, run.Text, tc.want[i])
+				}
+				if run.Face.Font.Typeface != tc.tf[i] {
+					t.Errorf("invalid run %d typeface: got=%q, want=%q", i, run.Face.Font.Typeface, tc.tf[i])
+				}
+				if run.Face.Font.Size != 12 {
+					t.Errorf("invalid run %d size: got=%v, want=12", i, run.Face.Font.Size)
+				}
+				width += run.Face.Width(run.Text)
+			}
+			if width <= 0 {
+				t.Errorf("invalid width: got=%v", width)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package font
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+)
+
+// Load returns the collection of the font Faces of the TrueType and
+// OpenType font files, and font collection files, at the given paths,
+// in order. The font descriptors of the font Faces are read from the
+// font data, as described in Parse.
+func Load(paths ...string) (Collection, error) {
+	var coll Collection
+	for _, path := range paths {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("font: could not read font file: %w", err)
+		}
+		faces, err := Parse(src)
+		if err != nil {
+			return nil, fmt.Errorf("font: could not load %q: %w", path, err)
+		}
+		coll = append(coll, faces...)
+	}
+	return coll, nil
+}
+
+// LoadDir returns the collection of the font Faces of the font files
+// in the directory dir and its subdirectories, in lexical order of their
+// paths. Font files are recognized by their .ttf, .otf, .ttc or .otc
+// extension, regardless of case. Other files are ignored.
+func LoadDir(dir string) (Collection, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".ttf", ".otf", ".ttc", ".otc":
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("font: could not scan font directory: %w", err)
+	}
+	return Load(paths...)
+}
+
+// Parse returns the collection of the font Faces of the TrueType or
+// OpenType font data, or font collection data, src.
+//
+// The font descriptors of the font Faces are read from the font data:
+//   - the Typeface is the typographic family name of the font, or its
+//     family name, from the name table. A trailing "Sans", "Serif",
+//     "Mono" or "Sans Mono" is split off as the Variant, so that the
+//     "Liberation Serif" family has the "Liberation" Typeface and the
+//     "Serif" Variant,
+//   - the Weight is read from the weight class of the OS/2 table, and
+//     is at least Bold for fonts flagged as bold in the OS/2 table,
+//   - the Style is read from the selection flags of the OS/2 table.
+//
+// Fonts without an OS/2 table have their Weight and Style read from
+// their subfamily name, such as "Bold Italic".
+//
+// The caller should not modify src while the font Faces remain in use.
+func Parse(src []byte) (Collection, error) {
+	fonts, err := opentype.ParseCollection(src)
+	if err != nil {
+		return nil, fmt.Errorf("font: could not parse font data: %w", err)
+	}
+	offsets := sfntOffsets(src)
+	if len(offsets) != fonts.NumFonts() {
+		offsets = nil
+	}
+
+	var (
+		buf  sfnt.Buffer
+		coll = make(Collection, 0, fonts.NumFonts())
+	)
+	for i := 0; i < fonts.NumFonts(); i++ {
+		face, err := fonts.Font(i)
+		if err != nil {
+			return nil, fmt.Errorf("font: could not parse font %d: %w", i, err)
+		}
+		family, err := fontName(face, &buf, sfnt.NameIDTypographicFamily, sfnt.NameIDFamily)
+		if err != nil {
+			return nil, fmt.Errorf("font: could not read family name of font %d: %w", i, err)
+		}
+		var fnt Font
+		fnt.Typeface, fnt.Variant = splitFamily(family)
+
+		var os2 []byte
+		if offsets != nil {
+			os2 = sfntTable(src, offsets[i], "OS/2")
+		}
+		if len(os2) >= 64 {
+			sel := binary.BigEndian.Uint16(os2[62:])
+			fnt.Weight = weightFromClass(binary.BigEndian.Uint16(os2[4:]))
+			if sel&fsSelectionBold != 0 {
+				// The bold face of a family may have
+				// a lighter weight class, such as 600.
+				fnt.Weight = max(fnt.Weight, font.WeightBold)
+			}
+			fnt.Style = styleFromSelection(sel)
+		} else {
+			sub, _ := fontName(face, &buf, sfnt.NameIDTypographicSubfamily, sfnt.NameIDSubfamily)
+			fnt.Weight, fnt.Style = parseSubfamily(sub)
+		}
+		coll = append(coll, Face{Font: fnt, Face: face})
+	}
+	return coll, nil
+}
+
+// fontName returns the first non-empty name of the font
+// keyed by the given NameIDs.
+func fontName(f *opentype.Font, buf *sfnt.Buffer, ids ...sfnt.NameID) (string, error) {
+	var err error
+	for _, id := range ids {
+		var name string
+		name, err = f.Name(buf, id)
+		if err == nil && name != "" {
+			return name, nil
+		}
+	}
+	return "", err
+}
+
+// variants are the family name suffixes split off as font
+// Variants, longest first.
+var variants = []struct {
+	suffix  string
+	variant Variant
+}{
+	{" Sans Mono", "SansMono"},
+	{" Mono", "Mono"},
+	{" Sans", "Sans"},
+	{" Serif", "Serif"},
+}
+
+// splitFamily splits a family name into a Typeface and a Variant.
+func splitFamily(family string) (Typeface, Variant) {
+	family = strings.TrimSpace(family)
+	for _, v := range variants {
+		tf, ok := strings.CutSuffix(family, v.suffix)
+		if ok && tf != "" {
+			return Typeface(tf), v.variant
+		}
+	}
+	return Typeface(family), ""
+}
+
+// sfntOffsets returns the offsets of the table directories
+// of the fonts of the font, or font collection, data src.
+func sfntOffsets(src []byte) []uint32 {
+	if len(src) < 12 {
+		return nil
+	}
+	if string(src[:4]) != "ttcf" {
+		return []uint32{0}
+	}
+	n := int(binary.BigEndian.Uint32(src[8:]))
+	if len(src) < 12+4*n {
+		return nil
+	}
+	offsets := make([]uint32, n)
+	for i := range offsets {
+		offsets[i] = binary.BigEndian.Uint32(src[12+4*i:])
+	}
+	return offsets
+}
+
+// sfntTable returns the table with the given tag of the font
+// whose table directory is at offset off in src, or nil if
+// there is no such table.
+func sfntTable(src []byte, off uint32, tag string) []byte {
+	return sfntTables(src, off)[tag]
+}
+
+// sfntTables returns the tables of the font whose table directory
+// is at offset off in src, keyed by their tags, or nil if the table
+// directory is invalid.
+func sfntTables(src []byte, off uint32) map[string][]byte {
+	if uint64(off)+12 > uint64(len(src)) {
+		return nil
+	}
+	dir := src[off:]
+	n := int(binary.BigEndian.Uint16(dir[4:]))
+	if len(dir) < 12+16*n {
+		return nil
+	}
+	tables := make(map[string][]byte, n)
+	for i := 0; i < n; i++ {
+		rec := dir[12+16*i:]
+		beg := uint64(binary.BigEndian.Uint32(rec[8:]))
+		end := beg + uint64(binary.BigEndian.Uint32(rec[12:]))
+		if end > uint64(len(src)) {
+			return nil
+		}
+		tables[string(rec[:4])] = src[beg:end]
+	}
+	return tables
+}
+
+// weightFromClass returns the font Weight of the OS/2
+// weight class, from 100 (thin) to 900 (black).
+func weightFromClass(class uint16) font.Weight {
+	w := (int(class)+50)/100 - 4
+	return font.Weight(min(max(w, int(font.WeightThin)), int(font.WeightBlack)))
+}
+
+// Font selection flags of the OS/2 table.
+const (
+	fsSelectionItalic  = 1 << 0
+	fsSelectionBold    = 1 << 5
+	fsSelectionOblique = 1 << 9
+)
+
+// styleFromSelection returns the font Style of the OS/2
+// font selection flags.
+func styleFromSelection(sel uint16) font.Style {
+	switch {
+	case sel&fsSelectionOblique != 0:
+		return font.StyleOblique
+	case sel&fsSelectionItalic != 0:
+		return font.StyleItalic
+	default:
+		return font.StyleNormal
+	}
+}
+
+// parseSubfamily returns the font Weight and Style
+// named by a subfamily name, such as "Bold Italic".
+func parseSubfamily(sub string) (font.Weight, font.Style) {
+	var (
+		weight = font.WeightNormal
+		style  = font.StyleNormal
+	)
+	name := strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(sub))
+	for _, w := range []struct {
+		name   string
+		weight font.Weight
+	}{
+		// Compound names come before their suffixes.
+		{"extralight", font.WeightExtraLight},
+		{"ultralight", font.WeightExtraLight},
+		{"semibold", font.WeightSemiBold},
+		{"demibold", font.WeightSemiBold},
+		{"extrabold", font.WeightExtraBold},
+		{"ultrabold", font.WeightExtraBold},
+		{"thin", font.WeightThin},
+		{"light", font.WeightLight},
+		{"medium", font.WeightMedium},
+		{"bold", font.WeightBold},
+		{"black", font.WeightBlack},
+		{"heavy", font.WeightBlack},
+	} {
+		if strings.Contains(name, w.name) {
+			weight = w.weight
+			break
+		}
+	}
+	switch {
+	case strings.Contains(name, "oblique"):
+		style = font.StyleOblique
+	case strings.Contains(name, "italic"):
+		style = font.StyleItalic
+	}
+	return weight, style
+}
@@ -0,0 +1,229 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package font_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"codeberg.org/go-fonts/liberation/liberationmonobold"
+	"codeberg.org/go-fonts/liberation/liberationmonobolditalic"
+	"codeberg.org/go-fonts/liberation/liberationmonoitalic"
+	"codeberg.org/go-fonts/liberation/liberationmonoregular"
+	"codeberg.org/go-fonts/liberation/liberationsansbold"
+	"codeberg.org/go-fonts/liberation/liberationsansbolditalic"
+	"codeberg.org/go-fonts/liberation/liberationsansitalic"
+	"codeberg.org/go-fonts/liberation/liberationsansregular"
+	"codeberg.org/go-fonts/liberation/liberationserifbold"
+	"codeberg.org/go-fonts/liberation/liberationserifbolditalic"
+	"codeberg.org/go-fonts/liberation/liberationserifitalic"
+	"codeberg.org/go-fonts/liberation/liberationserifregular"
+	stdfnt "golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobolditalic"
+	"golang.org/x/image/font/gofont/gomedium"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/goregular"
+
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/font/liberation"
+)
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	for name, ttf := range map[string][]byte{
+		"serif/LiberationSerif-Regular.ttf":    liberationserifregular.TTF,
+		"serif/LiberationSerif-Italic.ttf":     liberationserifitalic.TTF,
+		"serif/LiberationSerif-Bold.ttf":       liberationserifbold.TTF,
+		"serif/LiberationSerif-BoldItalic.ttf": liberationserifbolditalic.TTF,
+		"LiberationMono-Regular.TTF":           liberationmonoregular.TTF,
+		"LiberationMono-Italic.ttf":            liberationmonoitalic.TTF,
+		"LiberationMono-Bold.ttf":              liberationmonobold.TTF,
+		"LiberationMono-BoldItalic.ttf":        liberationmonobolditalic.TTF,
+		"sans/LiberationSans-Regular.ttf":      liberationsansregular.TTF,
+		"sans/LiberationSans-Italic.ttf":       liberationsansitalic.TTF,
+		"sans/LiberationSans-Bold.ttf":         liberationsansbold.TTF,
+		"sans/LiberationSans-BoldItalic.ttf":   liberationsansbolditalic.TTF,
+		"README.txt":                           []byte("not a font"),
+	} {
+		writeFile(t, filepath.Join(dir, name), ttf)
+	}
+
+	coll, err := font.LoadDir(dir)
+	if err != nil {
+		t.Fatalf("could not load fonts: %+v", err)
+	}
+
+	// Loading the Liberation fonts from files yields
+	// the font descriptors of the compiled-in collection.
+	if got, want := descriptors(coll), descriptors(liberation.Collection()); !reflect.DeepEqual(got, want) {
+		t.Errorf("invalid font descriptors:\ngot= %v\nwant=%v", got, want)
+	}
+
+	cache := font.NewCache(coll)
+	fnt := font.Font{Typeface: "Liberation", Variant: "Mono", Weight: stdfnt.WeightBold}
+	if !cache.Has(fnt) {
+		t.Errorf("missing font %v", fnt)
+	}
+	face := cache.Lookup(fnt, 12)
+	ref := font.NewCache(liberation.Collection()).Lookup(fnt, 12)
+	if got, want := face.Width("hello"), ref.Width("hello"); got != want {
+		t.Errorf("invalid width: got=%v, want=%v", got, want)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	var paths []string
+	for _, tc := range []struct {
+		name string
+		ttf  []byte
+	}{
+		{"Go-Regular.ttf", goregular.TTF},
+		{"Go-Medium.ttf", gomedium.TTF},
+		{"Go-BoldItalic.ttf", gobolditalic.TTF},
+		{"Go-Mono.ttf", gomono.TTF},
+	} {
+		path := filepath.Join(dir, tc.name)
+		writeFile(t, path, tc.ttf)
+		paths = append(paths, path)
+	}
+
+	coll, err := font.Load(paths...)
+	if err != nil {
+		t.Fatalf("could not load fonts: %+v", err)
+	}
+	got := make([]font.Font, len(coll))
+	for i, f := range coll {
+		got[i] = f.Font
+	}
+	want := []font.Font{
+		{Typeface: "Go"},
+		{Typeface: "Go Medium", Weight: stdfnt.WeightMedium},
+		{Typeface: "Go", Style: stdfnt.StyleItalic, Weight: stdfnt.WeightBold},
+		{Typeface: "Go", Variant: "Mono"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("invalid font descriptors:\ngot= %v\nwant=%v", got, want)
+	}
+
+	_, err = font.Load(filepath.Join(dir, "missing.ttf"))
+	if err == nil {
+		t.Errorf("expected an error loading a missing file")
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		src  []byte
+		want []font.Font
+	}{
+		{
+			name: "collection",
+			src:  makeTTC(liberationsansregular.TTF, liberationserifbolditalic.TTF),
+			want: []font.Font{
+				{Typeface: "Liberation", Variant: "Sans"},
+				{Typeface: "Liberation", Variant: "Serif", Style: stdfnt.StyleItalic, Weight: stdfnt.WeightBold},
+			},
+		},
+		{
+			// Without an OS/2 table, the weight and the
+			// style are read from the subfamily name.
+			name: "no-os2",
+			src:  hideTable(liberationmonobolditalic.TTF, "OS/2", "OS/3"),
+			want: []font.Font{
+				{Typeface: "Liberation", Variant: "Mono", Style: stdfnt.StyleItalic, Weight: stdfnt.WeightBold},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			coll, err := font.Parse(tc.src)
+			if err != nil {
+				t.Fatalf("could not parse fonts: %+v", err)
+			}
+			got := make([]font.Font, len(coll))
+			for i, f := range coll {
+				got[i] = f.Font
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("invalid font descriptors:\ngot= %v\nwant=%v", got, tc.want)
+			}
+		})
+	}
+
+	_, err := font.Parse([]byte("not a font"))
+	if err == nil {
+		t.Errorf("expected an error parsing invalid font data")
+	}
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	err := os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		t.Fatalf("could not create directory: %+v", err)
+	}
+	err = os.WriteFile(path, data, 0o644)
+	if err != nil {
+		t.Fatalf("could not write file: %+v", err)
+	}
+}
+
+// descriptors returns the sorted names of the font descriptors of coll.
+func descriptors(coll font.Collection) []string {
+	names := make([]string, len(coll))
+	for i, f := range coll {
+		names[i] = f.Name()
+	}
+	sort.Strings(names)
+	return names
+}
+
+// makeTTC returns a font collection of the given fonts.
+func makeTTC(fonts ...[]byte) []byte {
+	hdr := 12 + 4*len(fonts)
+	out := new(bytes.Buffer)
+	out.WriteString("ttcf")
+	_ = binary.Write(out, binary.BigEndian, uint32(0x00010000))
+	_ = binary.Write(out, binary.BigEndian, uint32(len(fonts)))
+	off := hdr
+	for _, f := range fonts {
+		_ = binary.Write(out, binary.BigEndian, uint32(off))
+		off += len(f)
+	}
+	off = hdr
+	for _, f := range fonts {
+		// Table offsets are relative to the start of the collection.
+		f = append([]byte(nil), f...)
+		n := int(binary.BigEndian.Uint16(f[4:]))
+		for i := 0; i < n; i++ {
+			rec := f[12+16*i:]
+			binary.BigEndian.PutUint32(rec[8:], binary.BigEndian.Uint32(rec[8:])+uint32(off))
+		}
+		out.Write(f)
+		off += len(f)
+	}
+	return out.Bytes()
+}
+
+// hideTable returns a copy of the font with the table of the
+// given tag renamed to name, so that it is ignored. The name
+// must keep the table directory sorted.
+func hideTable(ttf []byte, tag, name string) []byte {
+	ttf = append([]byte(nil), ttf...)
+	n := int(binary.BigEndian.Uint16(ttf[4:]))
+	for i := 0; i < n; i++ {
+		rec := ttf[12+16*i:]
+		if string(rec[:4]) == tag {
+			copy(rec, name)
+		}
+	}
+	return ttf
+}
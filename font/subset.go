@@ -0,0 +1,446 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package font
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// subsetTables are the tables kept in font subsets. The tables
+// referring to glyphs and not rewritten, such as the OpenType
+// layout tables, are dropped.
+var subsetTables = []string{
+	"OS/2", "cmap", "cvt ", "fpgm", "gasp", "glyf", "head",
+	"hhea", "hmtx", "loca", "maxp", "name", "post", "prep",
+}
+
+// Subset returns the TrueType font data src reduced to the glyphs of the
+// given runes, the glyphs they are composed of, and the glyph drawn for
+// missing runes. The glyphs are renumbered, and the glyf, loca, cmap and
+// hmtx tables are rewritten accordingly. The kerning of the glyphs, from
+// the kern or the GPOS table, is kept in a kern table. The other OpenType
+// layout tables are dropped, as well as the glyph names of the post table.
+//
+// Only fonts with TrueType outlines can be subset. Subset returns an
+// error for fonts with CFF outlines and for font collections.
+func Subset(src []byte, runes []rune) ([]byte, error) {
+	if len(src) >= 4 && string(src[:4]) == "ttcf" {
+		return nil, errors.New("font: subsetting font collections is not supported")
+	}
+	tables := sfntTables(src, 0)
+	if tables == nil {
+		return nil, errors.New("font: invalid font table directory")
+	}
+	if tables["glyf"] == nil {
+		return nil, errors.New("font: subsetting fonts without TrueType outlines is not supported")
+	}
+	for _, tag := range []string{"head", "hhea", "hmtx", "loca", "maxp"} {
+		if tables[tag] == nil {
+			return nil, fmt.Errorf("font: missing %q table", tag)
+		}
+	}
+	fnt, err := sfnt.Parse(src)
+	if err != nil {
+		return nil, fmt.Errorf("font: could not parse font: %w", err)
+	}
+
+	s := subsetter{tables: tables}
+	if err := s.glyphs(); err != nil {
+		return nil, err
+	}
+
+	// Collect the glyphs of the runes and their components.
+	var (
+		buf   sfnt.Buffer
+		cmap  = make(map[rune]uint16)
+		keep  = map[uint16]bool{0: true}
+		stack []uint16
+	)
+	for _, r := range runes {
+		idx, err := fnt.GlyphIndex(&buf, r)
+		if err != nil || idx == 0 {
+			continue
+		}
+		gid := uint16(idx)
+		cmap[r] = gid
+		if !keep[gid] {
+			keep[gid] = true
+			stack = append(stack, gid)
+		}
+	}
+	for len(stack) > 0 {
+		gid := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, c := range s.components(gid) {
+			comp := binary.BigEndian.Uint16(s.glyph(gid)[c:])
+			if !keep[comp] && int(comp) < s.numGlyphs {
+				keep[comp] = true
+				stack = append(stack, comp)
+			}
+		}
+	}
+	order := make([]uint16, 0, len(keep))
+	for gid := range keep {
+		order = append(order, gid)
+	}
+	sort.Slice(order, func(i, j int) bool { return order[i] < order[j] })
+	ids := make(map[uint16]uint16, len(order))
+	for i, gid := range order {
+		ids[gid] = uint16(i)
+	}
+	for r, gid := range cmap {
+		cmap[r] = ids[gid]
+	}
+
+	out := make(map[string][]byte, len(subsetTables))
+	for _, tag := range subsetTables {
+		if t := tables[tag]; t != nil {
+			out[tag] = t
+		}
+	}
+	out["glyf"], out["loca"] = s.glyf(order, ids)
+	out["hmtx"] = s.hmtx(order)
+	out["cmap"] = subsetCmap(cmap)
+	if kern := subsetKern(fnt, order); kern != nil {
+		out["kern"] = kern
+	}
+
+	n := uint16(len(order))
+	head := append([]byte(nil), tables["head"]...)
+	binary.BigEndian.PutUint32(head[8:], 0)  // checkSumAdjustment
+	binary.BigEndian.PutUint16(head[50:], 1) // indexToLocFormat
+	out["head"] = head
+	hhea := append([]byte(nil), tables["hhea"]...)
+	binary.BigEndian.PutUint16(hhea[34:], n) // numberOfHMetrics
+	out["hhea"] = hhea
+	maxp := append([]byte(nil), tables["maxp"]...)
+	binary.BigEndian.PutUint16(maxp[4:], n) // numGlyphs
+	out["maxp"] = maxp
+	if post := tables["post"]; len(post) >= 32 {
+		post = append([]byte(nil), post[:32]...)
+		binary.BigEndian.PutUint32(post, 0x00030000) // no glyph names
+		out["post"] = post
+	}
+
+	return writeSFNT(out), nil
+}
+
+// subsetter holds the glyph data of a font being subset.
+type subsetter struct {
+	tables    map[string][]byte
+	numGlyphs int
+	loca      []uint32 // loca holds the offsets of the glyphs in glyf.
+}
+
+// glyphs reads the offsets of the glyphs of the font.
+func (s *subsetter) glyphs() error {
+	var (
+		head = s.tables["head"]
+		maxp = s.tables["maxp"]
+		loca = s.tables["loca"]
+		glyf = s.tables["glyf"]
+	)
+	if len(head) < 54 || len(maxp) < 6 || len(s.tables["hhea"]) < 36 {
+		return errors.New("font: invalid font header tables")
+	}
+	s.numGlyphs = int(binary.BigEndian.Uint16(maxp[4:]))
+	s.loca = make([]uint32, s.numGlyphs+1)
+	long := binary.BigEndian.Uint16(head[50:]) != 0
+	for i := range s.loca {
+		switch {
+		case long && len(loca) >= 4*(i+1):
+			s.loca[i] = binary.BigEndian.Uint32(loca[4*i:])
+		case !long && len(loca) >= 2*(i+1):
+			s.loca[i] = 2 * uint32(binary.BigEndian.Uint16(loca[2*i:]))
+		default:
+			return errors.New("font: invalid loca table")
+		}
+		if s.loca[i] > uint32(len(glyf)) || i > 0 && s.loca[i] < s.loca[i-1] {
+			return errors.New("font: invalid loca table")
+		}
+	}
+	return nil
+}
+
+// glyph returns the glyf data of the glyph.
+func (s *subsetter) glyph(gid uint16) []byte {
+	if int(gid) >= s.numGlyphs {
+		return nil
+	}
+	return s.tables["glyf"][s.loca[gid]:s.loca[gid+1]]
+}
+
+// components returns the offsets, within the glyf data of the glyph,
+// of the glyph indices of its components if it is a composite glyph.
+func (s *subsetter) components(gid uint16) []int {
+	const (
+		argsAreWords   = 0x0001
+		haveScale      = 0x0008
+		moreComponents = 0x0020
+		haveXYScale    = 0x0040
+		haveTwoByTwo   = 0x0080
+	)
+	data := s.glyph(gid)
+	if len(data) < 10 || int16(binary.BigEndian.Uint16(data)) >= 0 {
+		return nil
+	}
+	var offs []int
+	for i := 10; i+4 <= len(data); {
+		flags := binary.BigEndian.Uint16(data[i:])
+		offs = append(offs, i+2)
+		i += 4
+		if flags&argsAreWords != 0 {
+			i += 4
+		} else {
+			i += 2
+		}
+		switch {
+		case flags&haveScale != 0:
+			i += 2
+		case flags&haveXYScale != 0:
+			i += 4
+		case flags&haveTwoByTwo != 0:
+			i += 8
+		}
+		if flags&moreComponents == 0 {
+			break
+		}
+	}
+	return offs
+}
+
+// glyf returns the glyf and long loca tables of the glyphs in order,
+// with the glyph indices of their components renumbered.
+func (s *subsetter) glyf(order []uint16, ids map[uint16]uint16) (glyf, loca []byte) {
+	loca = make([]byte, 4*(len(order)+1))
+	for i, gid := range order {
+		data := append([]byte(nil), s.glyph(gid)...)
+		for _, c := range s.components(gid) {
+			comp := binary.BigEndian.Uint16(data[c:])
+			binary.BigEndian.PutUint16(data[c:], ids[comp])
+		}
+		glyf = append(glyf, data...)
+		for len(glyf)%4 != 0 {
+			glyf = append(glyf, 0)
+		}
+		binary.BigEndian.PutUint32(loca[4*(i+1):], uint32(len(glyf)))
+	}
+	return glyf, loca
+}
+
+// hmtx returns the hmtx table of the glyphs in order,
+// with the metrics of all the glyphs in full.
+func (s *subsetter) hmtx(order []uint16) []byte {
+	var (
+		hmtx = s.tables["hmtx"]
+		nh   = int(binary.BigEndian.Uint16(s.tables["hhea"][34:]))
+		out  = make([]byte, 4*len(order))
+	)
+	for i, gid := range order {
+		g := int(gid)
+		var adv, lsb []byte
+		switch {
+		case g < nh && len(hmtx) >= 4*(g+1):
+			adv, lsb = hmtx[4*g:4*g+2], hmtx[4*g+2:4*g+4]
+		case nh > 0 && len(hmtx) >= 4*nh+2*(g-nh+1):
+			adv, lsb = hmtx[4*(nh-1):4*(nh-1)+2], hmtx[4*nh+2*(g-nh):4*nh+2*(g-nh)+2]
+		default:
+			continue
+		}
+		copy(out[4*i:], adv)
+		copy(out[4*i+2:], lsb)
+	}
+	return out
+}
+
+// subsetCmap returns a cmap table mapping the runes to their glyphs,
+// with a format 4 subtable for the runes of the Basic Multilingual
+// Plane, and a format 12 subtable for all the runes if some are
+// outside of it.
+func subsetCmap(cmap map[rune]uint16) []byte {
+	runes := make([]rune, 0, len(cmap))
+	for r := range cmap {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	// segment is a range of runes mapped to consecutive glyphs.
+	type segment struct {
+		start, end rune
+		gid        uint16
+	}
+	var all, bmp []segment
+	for _, r := range runes {
+		gid := cmap[r]
+		if n := len(all); n > 0 && all[n-1].end+1 == r && rune(all[n-1].gid)+r-all[n-1].start == rune(gid) {
+			all[n-1].end = r
+		} else {
+			all = append(all, segment{start: r, end: r, gid: gid})
+		}
+	}
+	for _, seg := range all {
+		if seg.start > 0xfffe {
+			break
+		}
+		seg.end = min(seg.end, 0xfffe)
+		bmp = append(bmp, seg)
+	}
+	bmp = append(bmp, segment{start: 0xffff, end: 0xffff, gid: 0})
+
+	// Format 4 subtable.
+	var f4 bytes.Buffer
+	n := len(bmp)
+	sr := 2 << (bits.Len(uint(n)) - 1)
+	put16 := func(b *bytes.Buffer, vs ...uint16) {
+		for _, v := range vs {
+			_ = binary.Write(b, binary.BigEndian, v)
+		}
+	}
+	put16(&f4, 4, uint16(16+8*n), 0, uint16(2*n), uint16(sr), uint16(bits.Len(uint(sr/2))-1), uint16(2*n-sr))
+	for _, seg := range bmp {
+		put16(&f4, uint16(seg.end))
+	}
+	put16(&f4, 0)
+	for _, seg := range bmp {
+		put16(&f4, uint16(seg.start))
+	}
+	for _, seg := range bmp {
+		delta := uint16(seg.gid) - uint16(seg.start)
+		if seg.start == 0xffff {
+			delta = 1
+		}
+		put16(&f4, delta)
+	}
+	for range bmp {
+		put16(&f4, 0)
+	}
+
+	// Format 12 subtable.
+	var f12 bytes.Buffer
+	if len(runes) > 0 && runes[len(runes)-1] > 0xffff {
+		put16(&f12, 12, 0)
+		_ = binary.Write(&f12, binary.BigEndian, []uint32{uint32(16 + 12*len(all)), 0, uint32(len(all))})
+		for _, seg := range all {
+			_ = binary.Write(&f12, binary.BigEndian, []uint32{uint32(seg.start), uint32(seg.end), uint32(seg.gid)})
+		}
+	}
+
+	var out bytes.Buffer
+	if f12.Len() == 0 {
+		put16(&out, 0, 1, 3, 1)
+		_ = binary.Write(&out, binary.BigEndian, uint32(12))
+		out.Write(f4.Bytes())
+		return out.Bytes()
+	}
+	put16(&out, 0, 2, 3, 1)
+	_ = binary.Write(&out, binary.BigEndian, uint32(20))
+	put16(&out, 3, 10)
+	_ = binary.Write(&out, binary.BigEndian, uint32(20+f4.Len()))
+	out.Write(f4.Bytes())
+	out.Write(f12.Bytes())
+	return out.Bytes()
+}
+
+// subsetKern returns a kern table holding the kerning of the pairs of
+// glyphs in order, renumbered by their index in order, or nil if none
+// of the pairs is kerned. The kerning is read from the kern or the GPOS
+// table of fnt.
+func subsetKern(fnt *sfnt.Font, order []uint16) []byte {
+	const maxPairs = 0xffff
+	var (
+		buf sfnt.Buffer
+		// Kerning values are returned in font units
+		// for a size of one unit per em.
+		ppem  = fixed.Int26_6(fnt.UnitsPerEm())
+		pairs bytes.Buffer
+		n     int
+	)
+	for i, left := range order {
+		for j, right := range order {
+			k, err := fnt.Kern(&buf, sfnt.GlyphIndex(left), sfnt.GlyphIndex(right), ppem, font.HintingNone)
+			if err != nil || k == 0 || n == maxPairs {
+				continue
+			}
+			_ = binary.Write(&pairs, binary.BigEndian, []uint16{uint16(i), uint16(j), uint16(int16(k))})
+			n++
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+
+	var out bytes.Buffer
+	sr := 6 << (bits.Len(uint(n)) - 1)
+	_ = binary.Write(&out, binary.BigEndian, []uint16{
+		0, 1, // version and number of subtables
+		// The length of large subtables is truncated,
+		// as done by fonts with many kerning pairs.
+		0, uint16(14 + pairs.Len()), 0x0001, // version, length and horizontal format 0 coverage
+		uint16(n), uint16(sr), uint16(bits.Len(uint(n)) - 1), uint16(6*n - sr),
+	})
+	out.Write(pairs.Bytes())
+	return out.Bytes()
+}
+
+// writeSFNT returns the font data of the TrueType font made of the
+// tables, with their checksums and the font checksum adjustment set.
+func writeSFNT(tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	n := len(tags)
+	sr := 16 << (bits.Len(uint(n)) - 1)
+	hdr := make([]byte, 12+16*n)
+	binary.BigEndian.PutUint32(hdr, 0x00010000)
+	binary.BigEndian.PutUint16(hdr[4:], uint16(n))
+	binary.BigEndian.PutUint16(hdr[6:], uint16(sr))
+	binary.BigEndian.PutUint16(hdr[8:], uint16(bits.Len(uint(n))-1))
+	binary.BigEndian.PutUint16(hdr[10:], uint16(16*n-sr))
+
+	out := bytes.NewBuffer(hdr)
+	var headOff int
+	for i, tag := range tags {
+		data := tables[tag]
+		rec := hdr[12+16*i:]
+		copy(rec, tag)
+		binary.BigEndian.PutUint32(rec[4:], sfntChecksum(data))
+		binary.BigEndian.PutUint32(rec[8:], uint32(out.Len()))
+		binary.BigEndian.PutUint32(rec[12:], uint32(len(data)))
+		if tag == "head" {
+			headOff = out.Len()
+		}
+		out.Write(data)
+		for out.Len()%4 != 0 {
+			out.WriteByte(0)
+		}
+	}
+	raw := out.Bytes()
+	copy(raw, hdr)
+	binary.BigEndian.PutUint32(raw[headOff+8:], 0xb1b0afba-sfntChecksum(raw))
+	return raw
+}
+
+// sfntChecksum returns the checksum of the table data.
+func sfntChecksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
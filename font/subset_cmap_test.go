@@ -0,0 +1,57 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package font
+
+import (
+	"testing"
+
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/sfnt"
+)
+
+func TestSubsetCmap(t *testing.T) {
+	raw, err := Subset(goregular.TTF, []rune("abc"))
+	if err != nil {
+		t.Fatalf("could not subset font: %+v", err)
+	}
+
+	// Map runes inside and outside of the Basic Multilingual
+	// Plane to the glyphs of the subset font.
+	want := map[rune]sfnt.GlyphIndex{
+		'a':          1,
+		'b':          2,
+		'c':          3,
+		'é':          3,
+		'\U0001d400': 1,
+		'\U0001d401': 2,
+		'\U0001f600': 3,
+	}
+	cmap := make(map[rune]uint16, len(want))
+	for r, gid := range want {
+		cmap[r] = uint16(gid)
+	}
+	tables := sfntTables(raw, 0)
+	tables["cmap"] = subsetCmap(cmap)
+
+	fnt, err := sfnt.Parse(writeSFNT(tables))
+	if err != nil {
+		t.Fatalf("could not parse font: %+v", err)
+	}
+	var buf sfnt.Buffer
+	for r, gid := range want {
+		got, err := fnt.GlyphIndex(&buf, r)
+		if err != nil {
+			t.Fatalf("could not find glyph of %q: %+v", r, err)
+		}
+		if got != gid {
+			t.Errorf("invalid glyph of %q: got=%d, want=%d", r, got, gid)
+		}
+	}
+	for _, r := range "dz\U0001d402" {
+		if got, _ := fnt.GlyphIndex(&buf, r); got != 0 {
+			t.Errorf("unexpected glyph of %q: %d", r, got)
+		}
+	}
+}
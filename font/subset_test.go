@@ -0,0 +1,170 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package font_test
+
+import (
+	"reflect"
+	"testing"
+
+	"codeberg.org/go-fonts/liberation/liberationserifregular"
+	stdfnt "golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+
+	"gonum.org/v1/plot/font"
+)
+
+func TestSubset(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		ttf   []byte
+		runes string
+	}{
+		{name: "empty", ttf: liberationserifregular.TTF, runes: ""},
+		{name: "ascii", ttf: liberationserifregular.TTF, runes: "Hello, World! 0123456789"},
+		// Accented letters are composite glyphs in the Liberation fonts.
+		{name: "composite", ttf: liberationserifregular.TTF, runes: "déjà vu, Ångström"},
+		{name: "symbols", ttf: goregular.TTF, runes: "x⁰¹²⁻ ±×÷"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			runes := []rune(tc.runes)
+			raw, err := font.Subset(tc.ttf, runes)
+			if err != nil {
+				t.Fatalf("could not subset font: %+v", err)
+			}
+			if len(raw) >= len(tc.ttf)/4 {
+				t.Errorf("subset too large: got=%d bytes, full font=%d bytes", len(raw), len(tc.ttf))
+			}
+
+			orig, err := sfnt.Parse(tc.ttf)
+			if err != nil {
+				t.Fatalf("could not parse font: %+v", err)
+			}
+			sub, err := sfnt.Parse(raw)
+			if err != nil {
+				t.Fatalf("could not parse subset font: %+v", err)
+			}
+
+			var (
+				buf  sfnt.Buffer
+				ppem = fixed.I(1000)
+			)
+			for _, r := range runes {
+				gid1, err := orig.GlyphIndex(&buf, r)
+				if err != nil {
+					t.Fatalf("could not find glyph of %q: %+v", r, err)
+				}
+				gid2, err := sub.GlyphIndex(&buf, r)
+				if err != nil {
+					t.Fatalf("could not find subset glyph of %q: %+v", r, err)
+				}
+				if gid2 == 0 {
+					t.Errorf("missing subset glyph of %q", r)
+					continue
+				}
+
+				adv1, err := orig.GlyphAdvance(&buf, gid1, ppem, stdfnt.HintingNone)
+				if err != nil {
+					t.Fatalf("could not get advance of %q: %+v", r, err)
+				}
+				adv2, err := sub.GlyphAdvance(&buf, gid2, ppem, stdfnt.HintingNone)
+				if err != nil {
+					t.Fatalf("could not get subset advance of %q: %+v", r, err)
+				}
+				if adv1 != adv2 {
+					t.Errorf("invalid advance of %q: got=%v, want=%v", r, adv2, adv1)
+				}
+
+				segs, err := orig.LoadGlyph(&buf, gid1, ppem, nil)
+				if err != nil {
+					t.Fatalf("could not load glyph of %q: %+v", r, err)
+				}
+				want := append(sfnt.Segments(nil), segs...)
+				got, err := sub.LoadGlyph(&buf, gid2, ppem, nil)
+				if err != nil {
+					t.Fatalf("could not load subset glyph of %q: %+v", r, err)
+				}
+				if (len(got) != 0 || len(want) != 0) && !reflect.DeepEqual(got, want) {
+					t.Errorf("invalid outline of %q", r)
+				}
+			}
+
+			// Runes not in the subset are missing.
+			if gid, _ := sub.GlyphIndex(&buf, 'Q'); gid != 0 {
+				t.Errorf("unexpected subset glyph of 'Q': %d", gid)
+			}
+			if got, want := sub.NumGlyphs(), len(runes)+1; got > want+8 {
+				t.Errorf("too many subset glyphs: got=%d, want about %d", got, want)
+			}
+
+			name, err := sub.Name(&buf, sfnt.NameIDFamily)
+			if err != nil || name == "" {
+				t.Errorf("missing subset family name: %q, %v", name, err)
+			}
+		})
+	}
+}
+
+func TestSubsetNonBMP(t *testing.T) {
+	// Runes outside of the Basic Multilingual Plane are absent
+	// from the font, and subsetting still yields a valid font.
+	raw, err := font.Subset(goregular.TTF, []rune("a\U0001F600b"))
+	if err != nil {
+		t.Fatalf("could not subset font: %+v", err)
+	}
+	sub, err := sfnt.Parse(raw)
+	if err != nil {
+		t.Fatalf("could not parse subset font: %+v", err)
+	}
+	var buf sfnt.Buffer
+	for _, r := range "ab" {
+		if gid, _ := sub.GlyphIndex(&buf, r); gid == 0 {
+			t.Errorf("missing subset glyph of %q", r)
+		}
+	}
+}
+
+func TestSubsetKern(t *testing.T) {
+	const txt = "AVATAR Wavy"
+	raw, err := font.Subset(liberationserifregular.TTF, []rune(txt))
+	if err != nil {
+		t.Fatalf("could not subset font: %+v", err)
+	}
+	orig, err := sfnt.Parse(liberationserifregular.TTF)
+	if err != nil {
+		t.Fatalf("could not parse font: %+v", err)
+	}
+	sub, err := sfnt.Parse(raw)
+	if err != nil {
+		t.Fatalf("could not parse subset font: %+v", err)
+	}
+
+	fnt := font.Font{Size: 12}
+	full := font.Face{Font: fnt, Face: orig}
+	subset := font.Face{Font: fnt, Face: sub}
+	for _, s := range []string{"AV", "VA", "TA", "Wa", txt} {
+		want := full.Width(s)
+		if got := subset.Width(s); got != want {
+			t.Errorf("unexpected kerned width of %q: got=%v, want=%v", s, got, want)
+		}
+	}
+	if full.Width("AV") >= full.Width("A")+full.Width("V") {
+		t.Errorf("unexpected unkerned reference width of %q", "AV")
+	}
+}
+
+func TestSubsetInvalid(t *testing.T) {
+	for _, src := range [][]byte{
+		nil,
+		[]byte("not a font"),
+		append([]byte("ttcf"), make([]byte, 16)...),
+	} {
+		_, err := font.Subset(src, []rune("abc"))
+		if err == nil {
+			t.Errorf("expected an error subsetting %q", src)
+		}
+	}
+}
@@ -47,4 +47,5 @@ func init() {
 	// text.Style
 	gob.Register(&text.Plain{})
 	gob.Register(&text.Latex{})
+	gob.Register(&text.Markup{})
 }
@@ -0,0 +1,234 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Grid describes a grid layout of plots where rows and
+// columns may have different sizes.
+type Grid struct {
+	// Tiles holds the number of rows and columns
+	// of the grid and the padding around and between
+	// its cells.
+	draw.Tiles
+
+	// Widths and Heights are the relative sizes of the
+	// data areas of the columns and rows of the grid.
+	// If Widths or Heights is nil, all the columns or
+	// rows have the same size respectively. Otherwise
+	// their length must match the number of columns
+	// or rows of the grid.
+	Widths, Heights []float64
+}
+
+// GridCell is a plot placed in a Grid.
+type GridCell struct {
+	// Plot is the plot drawn in the cell.
+	// Plot may be nil.
+	Plot *Plot
+
+	// Row and Col are the row and column
+	// of the top left of the cell. Rows are
+	// numbered from the top of the grid.
+	Row, Col int
+
+	// RowSpan and ColSpan are the number of
+	// rows and columns spanned by the cell.
+	// Zero values are treated as one.
+	RowSpan, ColSpan int
+}
+
+// span returns the first and last rows and
+// columns covered by the cell.
+func (c GridCell) span() (r0, r1, c0, c1 int) {
+	return c.Row, c.Row + max(c.RowSpan, 1) - 1, c.Col, c.Col + max(c.ColSpan, 1) - 1
+}
+
+// AlignGrid returns the Canvases of the cells placed in the grid g
+// drawn to dc, in the same order as cells. The DataCanvases of the plots
+// are aligned along the edges of the rows and columns they span, so that
+// plots sharing a row or a column line up, and the data areas of the rows
+// and columns are sized according to the Heights and Widths of g.
+// Cells with a nil Plot get the whole area of the rows and columns they
+// span. The canvases of the cells spanning the edges of the rows and
+// columns of a plot with an Aspect constraint met by adjusting its box
+// are shrunk along these edges, so that the DataCanvases stay aligned
+// with the constrained one.
+func AlignGrid(cells []GridCell, g Grid, dc draw.Canvas) []draw.Canvas {
+	widths := gridRatios("widths", g.Widths, g.Cols)
+	heights := gridRatios("heights", g.Heights, g.Rows)
+	checkCells(cells, g)
+
+	// Place the cells in a grid without margins
+	// to compute the margins of each plot.
+	noMargins := make([]float64, max(g.Rows, g.Cols))
+	xs := gridEdges(widths, noMargins, noMargins,
+		dc.Min.X+g.PadLeft, dc.Max.X-g.PadRight, g.PadX)
+	ys := gridEdges(heights, noMargins, noMargins,
+		-dc.Max.Y+g.PadTop, -dc.Min.Y-g.PadBottom, g.PadY)
+
+	type margins struct {
+		left, right, top, bottom float64
+	}
+	ms := make([]margins, len(cells))
+	left := make([]float64, g.Cols)
+	right := make([]float64, g.Cols)
+	top := make([]float64, g.Rows)
+	bottom := make([]float64, g.Rows)
+	for k, cell := range cells {
+		if cell.Plot == nil {
+			continue
+		}
+		r0, r1, c0, c1 := cell.span()
+		c := dc
+		c.Rectangle = vg.Rectangle{
+			Min: vg.Point{X: xs[c0].min, Y: -ys[r1].max},
+			Max: vg.Point{X: xs[c1].max, Y: -ys[r0].min},
+		}
+		dataC := cell.Plot.dataCanvas(c)
+		ms[k] = margins{
+			left:   float64(dataC.Min.X - c.Min.X),
+			right:  float64(c.Max.X - dataC.Max.X),
+			top:    float64(c.Max.Y - dataC.Max.Y),
+			bottom: float64(dataC.Min.Y - c.Min.Y),
+		}
+		left[c0] = math.Max(left[c0], ms[k].left)
+		right[c1] = math.Max(right[c1], ms[k].right)
+		top[r0] = math.Max(top[r0], ms[k].top)
+		bottom[r1] = math.Max(bottom[r1], ms[k].bottom)
+	}
+
+	// Size the data areas of the rows and columns
+	// with the maximum margins along each edge.
+	xs = gridEdges(widths, left, right,
+		dc.Min.X+g.PadLeft, dc.Max.X-g.PadRight, g.PadX)
+	ys = gridEdges(heights, top, bottom,
+		-dc.Max.Y+g.PadTop, -dc.Min.Y-g.PadBottom, g.PadY)
+
+	o := make([]draw.Canvas, len(cells))
+	for k, cell := range cells {
+		r0, r1, c0, c1 := cell.span()
+		o[k] = dc
+		if cell.Plot == nil {
+			o[k].Rectangle = vg.Rectangle{
+				Min: vg.Point{X: xs[c0].min, Y: -ys[r1].max},
+				Max: vg.Point{X: xs[c1].max, Y: -ys[r0].min},
+			}
+			continue
+		}
+		o[k].Rectangle = vg.Rectangle{
+			Min: vg.Point{
+				X: xs[c0].dataMin - vg.Length(ms[k].left),
+				Y: -ys[r1].dataMax - vg.Length(ms[k].bottom),
+			},
+			Max: vg.Point{
+				X: xs[c1].dataMax + vg.Length(ms[k].right),
+				Y: -ys[r0].dataMin + vg.Length(ms[k].top),
+			},
+		}
+	}
+
+	// Shrink the edges of the rows and columns
+	// spanned by plots with an aspect constraint.
+	var (
+		shrinkLeft   = make([]vg.Length, g.Cols)
+		shrinkRight  = make([]vg.Length, g.Cols)
+		shrinkTop    = make([]vg.Length, g.Rows)
+		shrinkBottom = make([]vg.Length, g.Rows)
+	)
+	for k, cell := range cells {
+		if cell.Plot == nil {
+			continue
+		}
+		r0, r1, c0, c1 := cell.span()
+		dx, dy := cell.Plot.aspectShrink(o[k])
+		shrinkLeft[c0] = max(shrinkLeft[c0], dx)
+		shrinkRight[c1] = max(shrinkRight[c1], dx)
+		shrinkTop[r0] = max(shrinkTop[r0], dy)
+		shrinkBottom[r1] = max(shrinkBottom[r1], dy)
+	}
+	for k, cell := range cells {
+		r0, r1, c0, c1 := cell.span()
+		o[k] = draw.Crop(o[k], shrinkLeft[c0], -shrinkRight[c1], shrinkBottom[r1], -shrinkTop[r0])
+	}
+	return o
+}
+
+// checkCells panics if a cell is out of the bounds of the grid g.
+func checkCells(cells []GridCell, g Grid) {
+	for k, cell := range cells {
+		r0, r1, c0, c1 := cell.span()
+		if r0 < 0 || c0 < 0 || r1 >= g.Rows || c1 >= g.Cols {
+			panic(fmt.Errorf("plot: cell %d out of grid bounds (%d×%d)", k, g.Rows, g.Cols))
+		}
+	}
+}
+
+// gridRatios returns the normalized relative sizes of n rows or
+// columns. It panics if ratios has the wrong length or holds
+// a negative value.
+func gridRatios(name string, ratios []float64, n int) []float64 {
+	if n <= 0 {
+		panic(fmt.Errorf("plot: invalid number of grid %s (%d)", name, n))
+	}
+	o := make([]float64, n)
+	if ratios == nil {
+		for i := range o {
+			o[i] = 1 / float64(n)
+		}
+		return o
+	}
+	if len(ratios) != n {
+		panic(fmt.Errorf("plot: grid %s length (%d) != %d", name, len(ratios), n))
+	}
+	var sum float64
+	for _, v := range ratios {
+		if v < 0 || math.IsNaN(v) {
+			panic(fmt.Errorf("plot: invalid grid %s ratio %v", name, v))
+		}
+		sum += v
+	}
+	if sum == 0 {
+		panic(fmt.Errorf("plot: grid %s ratios sum to zero", name))
+	}
+	for i, v := range ratios {
+		o[i] = v / sum
+	}
+	return o
+}
+
+// gridEdge holds the edges of a row or column of
+// a grid and of its data area.
+type gridEdge struct {
+	min, dataMin, dataMax, max vg.Length
+}
+
+// gridEdges returns the edges of the rows or columns of a grid
+// laid out between lo and hi, with the given relative sizes, the
+// given margins before and after the data area of each row or
+// column, and pad between them.
+func gridEdges(ratios, before, after []float64, lo, hi, pad vg.Length) []gridEdge {
+	space := float64(hi-lo) - float64(pad)*float64(len(ratios)-1)
+	for i := range ratios {
+		space -= before[i] + after[i]
+	}
+
+	o := make([]gridEdge, len(ratios))
+	x := lo
+	for i, r := range ratios {
+		o[i].min = x
+		o[i].dataMin = x + vg.Length(before[i])
+		o[i].dataMax = o[i].dataMin + vg.Length(r*space)
+		o[i].max = o[i].dataMax + vg.Length(after[i])
+		x = o[i].max + pad
+	}
+	return o
+}
@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"log"
+	"math"
+	"math/rand/v2"
+	"os"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
+)
+
+// ExampleAlignGrid draws a main panel with a narrow histogram
+// beside it and a wide time series spanning both columns
+// beneath it.
+func ExampleAlignGrid() {
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	const n = 200
+	series := make(plotter.XYs, n)
+	pts := make(plotter.XYs, n)
+	ys := make(plotter.Values, n)
+	for i := range series {
+		t := float64(i)
+		series[i].X = t
+		series[i].Y = math.Sin(t/20) + 0.2*rnd.NormFloat64()
+		pts[i].X = rnd.NormFloat64()
+		pts[i].Y = 100 * (pts[i].X + 0.5*rnd.NormFloat64())
+		ys[i] = pts[i].Y
+	}
+
+	p := plot.New()
+	p.Title.Text = "Main"
+	s, err := plotter.NewScatter(pts)
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Add(s)
+
+	hist := plot.New()
+	hist.Title.Text = "Histogram"
+	h, err := plotter.NewHist(ys, 16)
+	if err != nil {
+		log.Panic(err)
+	}
+	hist.Add(h)
+	hist.X.Tick.Marker = plot.ConstantTicks([]plot.Tick{
+		{Value: -200, Label: "-200"},
+		{Value: 0, Label: "0"},
+		{Value: 200, Label: "200"},
+	})
+
+	ts := plot.New()
+	ts.X.Label.Text = "Time"
+	l, err := plotter.NewLine(series)
+	if err != nil {
+		log.Panic(err)
+	}
+	ts.Add(l)
+
+	img := vgimg.New(vg.Points(300), vg.Points(250))
+	dc := draw.New(img)
+
+	g := plot.Grid{
+		Tiles: draw.Tiles{
+			Rows:      2,
+			Cols:      2,
+			PadX:      vg.Millimeter,
+			PadY:      vg.Millimeter,
+			PadTop:    vg.Points(2),
+			PadBottom: vg.Points(2),
+			PadLeft:   vg.Points(2),
+			PadRight:  vg.Points(2),
+		},
+		Widths:  []float64{3, 1},
+		Heights: []float64{2, 1},
+	}
+	cells := []plot.GridCell{
+		{Plot: p, Row: 0, Col: 0},
+		{Plot: hist, Row: 0, Col: 1},
+		{Plot: ts, Row: 1, Col: 0, ColSpan: 2},
+	}
+
+	canvases := plot.AlignGrid(cells, g, dc)
+	for i, cell := range cells {
+		cell.Plot.Draw(canvases[i])
+	}
+
+	w, err := os.Create("testdata/align_grid.png")
+	if err != nil {
+		log.Panic(err)
+	}
+	defer w.Close()
+	png := vgimg.PngCanvas{Canvas: img}
+	if _, err := png.WriteTo(w); err != nil {
+		log.Panic(err)
+	}
+}
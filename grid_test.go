@@ -0,0 +1,74 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestAlignGrid(t *testing.T) {
+	cmpimg.CheckPlot(ExampleAlignGrid, t, "align_grid.png")
+}
+
+func TestAlignGridEdges(t *testing.T) {
+	dc := draw.NewCanvas(new(recorder.Canvas), vg.Points(400), vg.Points(300))
+
+	plots := make([]*plot.Plot, 3)
+	for i := range plots {
+		plots[i] = plot.New()
+		plots[i].X.Max = 1e3 * float64(i+1)
+		plots[i].Y.Max = 1e6 * float64(i+1)
+	}
+	plots[1].Title.Text = "Title"
+
+	g := plot.Grid{
+		Tiles:   draw.Tiles{Rows: 2, Cols: 2},
+		Widths:  []float64{3, 1},
+		Heights: []float64{1, 2},
+	}
+	cells := []plot.GridCell{
+		{Plot: plots[0], Row: 0, Col: 0},
+		{Plot: plots[1], Row: 0, Col: 1},
+		{Plot: plots[2], Row: 1, Col: 0, ColSpan: 2},
+	}
+	cs := plot.AlignGrid(cells, g, dc)
+
+	data := make([]draw.Canvas, len(cs))
+	for i, c := range cs {
+		data[i] = plots[i].DataCanvas(c)
+	}
+
+	const tol = 1e-9
+	eq := func(a, b vg.Length) bool {
+		return vg.Length(math.Abs(float64(a-b))) < tol
+	}
+	if !eq(data[0].Max.Y, data[1].Max.Y) || !eq(data[0].Min.Y, data[1].Min.Y) {
+		t.Errorf("row data areas not aligned: %v %v", data[0].Rectangle, data[1].Rectangle)
+	}
+	if !eq(data[0].Min.X, data[2].Min.X) || !eq(data[1].Max.X, data[2].Max.X) {
+		t.Errorf("spanning data area not aligned: %v %v %v", data[0].Rectangle, data[1].Rectangle, data[2].Rectangle)
+	}
+
+	w0, w1 := data[0].Size().X, data[1].Size().X
+	if got, want := float64(w0/w1), 3.0; math.Abs(got-want) > tol {
+		t.Errorf("unexpected width ratio: got:%v want:%v", got, want)
+	}
+	h0, h2 := data[0].Size().Y, data[2].Size().Y
+	if got, want := float64(h2/h0), 2.0; math.Abs(got-want) > tol {
+		t.Errorf("unexpected height ratio: got:%v want:%v", got, want)
+	}
+	for i, c := range cs {
+		if c.Min.X < dc.Min.X || c.Max.X > dc.Max.X || c.Min.Y < dc.Min.Y || c.Max.Y > dc.Max.Y {
+			t.Errorf("canvas %d out of bounds: %v", i, c.Rectangle)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"image/color"
+
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Inset is a plot drawn inside the data area of a parent plot.
+type Inset struct {
+	// Plot is the plot drawn in the inset.
+	Plot *Plot
+
+	// XMin, YMin, XMax and YMax are the extent of the
+	// canvas of the inset plot, including its axes, as
+	// fractions of the data area of the parent plot.
+	XMin, YMin, XMax, YMax float64
+
+	// Zoom specifies whether the region of the parent
+	// plot shown by the inset plot is indicated by a
+	// rectangle, with lines connecting its corners to
+	// the corners of the data area of the inset plot.
+	// Lines crossing either rectangle are not drawn.
+	Zoom bool
+
+	// ZoomStyle is the style of the lines
+	// of the zoom indicator.
+	ZoomStyle draw.LineStyle
+}
+
+// AddInset adds the plot child as an inset of the plot p, drawn
+// after the plotters of p within the rectangle from (xmin, ymin)
+// to (xmax, ymax), in fractions of the data area of p. The region
+// shown by the inset is indicated on p.
+func (p *Plot) AddInset(child *Plot, xmin, ymin, xmax, ymax float64) *Inset {
+	in := &Inset{
+		Plot: child,
+		XMin: xmin,
+		YMin: ymin,
+		XMax: xmax,
+		YMax: ymax,
+		Zoom: true,
+		ZoomStyle: draw.LineStyle{
+			Color: color.Gray{Y: 96},
+			Width: vg.Points(0.5),
+		},
+	}
+	p.insets = append(p.insets, in)
+	return in
+}
+
+// draw draws the inset to the data area
+// dataC of its parent plot p.
+func (in *Inset) draw(dataC draw.Canvas, p *Plot) {
+	c := dataC
+	c.Rectangle = vg.Rectangle{
+		Min: vg.Point{X: dataC.X(in.XMin), Y: dataC.Y(in.YMin)},
+		Max: vg.Point{X: dataC.X(in.XMax), Y: dataC.Y(in.YMax)},
+	}
+	if in.Zoom {
+		in.drawZoom(dataC, p, in.Plot.DataCanvas(c).Rectangle)
+	}
+	in.Plot.Draw(c)
+}
+
+// drawZoom draws the zoom indicator on the data area dataC of
+// the parent plot p, connecting it to the data area r of the
+// inset plot.
+func (in *Inset) drawZoom(dataC draw.Canvas, p *Plot, r vg.Rectangle) {
+	trX, trY := p.Transforms(&dataC)
+	zoom := vg.Rectangle{
+		Min: vg.Point{X: trX(in.Plot.X.Min), Y: trY(in.Plot.Y.Min)},
+		Max: vg.Point{X: trX(in.Plot.X.Max), Y: trY(in.Plot.Y.Max)},
+	}
+	if zoom.Min.X > zoom.Max.X {
+		zoom.Min.X, zoom.Max.X = zoom.Max.X, zoom.Min.X
+	}
+	if zoom.Min.Y > zoom.Max.Y {
+		zoom.Min.Y, zoom.Max.Y = zoom.Max.Y, zoom.Min.Y
+	}
+	outline := []vg.Point{
+		zoom.Min,
+		{X: zoom.Max.X, Y: zoom.Min.Y},
+		zoom.Max,
+		{X: zoom.Min.X, Y: zoom.Max.Y},
+		zoom.Min,
+	}
+	dataC.StrokeLines(in.ZoomStyle, dataC.ClipLinesXY(outline)...)
+
+	corners := func(r vg.Rectangle) [4]vg.Point {
+		return [4]vg.Point{
+			r.Min,
+			{X: r.Max.X, Y: r.Min.Y},
+			r.Max,
+			{X: r.Min.X, Y: r.Max.Y},
+		}
+	}
+	from, to := corners(zoom), corners(r)
+	for i := range from {
+		if crosses(zoom, from[i], to[i]) || crosses(r, from[i], to[i]) {
+			continue
+		}
+		dataC.StrokeLines(in.ZoomStyle, dataC.ClipLinesXY([]vg.Point{from[i], to[i]})...)
+	}
+}
+
+// crosses returns whether the segment from p to q
+// crosses the interior of the rectangle r.
+func crosses(r vg.Rectangle, p, q vg.Point) bool {
+	// Clip the segment with the Liang-Barsky algorithm,
+	// ignoring the edges of the rectangle.
+	const eps = 1e-6
+	t0, t1 := 0.0, 1.0
+	d := q.Sub(p)
+	for _, e := range [...]struct{ p, q float64 }{
+		{-float64(d.X), float64(p.X-r.Min.X) - eps},
+		{float64(d.X), float64(r.Max.X-p.X) - eps},
+		{-float64(d.Y), float64(p.Y-r.Min.Y) - eps},
+		{float64(d.Y), float64(r.Max.Y-p.Y) - eps},
+	} {
+		switch {
+		case e.p == 0:
+			if e.q < 0 {
+				return false
+			}
+		case e.p < 0:
+			t0 = max(t0, e.q/e.p)
+		default:
+			t1 = min(t1, e.q/e.p)
+		}
+	}
+	return t0 < t1
+}
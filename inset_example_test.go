@@ -0,0 +1,45 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExamplePlot_AddInset draws a plot with an inset magnifying
+// a small region of the data, indicated on the parent plot.
+func ExamplePlot_AddInset() {
+	f := func(x float64) float64 {
+		return math.Sin(x) + 0.05*math.Sin(40*x)
+	}
+
+	p := plot.New()
+	p.Title.Text = "Inset"
+	p.X.Min, p.X.Max = 0, 2*math.Pi
+	p.Y.Min, p.Y.Max = -1.5, 1.5
+	p.Add(plotter.NewFunction(f))
+
+	child := plot.New()
+	child.X.Min, child.X.Max = 1.2, 1.9
+	child.Y.Min, child.Y.Max = 0.85, 1.1
+	child.X.Tick.Label.Font.Size = vg.Points(7)
+	child.Y.Tick.Label.Font.Size = vg.Points(7)
+	child.X.Padding, child.Y.Padding = 0, 0
+	fn := plotter.NewFunction(f)
+	fn.Samples = 200
+	child.Add(fn)
+
+	p.AddInset(child, 0.45, 0.6, 0.98, 0.98)
+
+	err := p.Save(300, 250, "testdata/inset.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
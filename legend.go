@@ -48,8 +48,14 @@ type Legend struct {
 	YPosition float64
 
 	// ThumbnailWidth is the width of legend thumbnails.
+	// The thumbnails are separated from the texts of the
+	// entries by the width of a space of the TextStyle.
 	ThumbnailWidth vg.Length
 
+	// Wrap specifies how the lines of the legend
+	// entry texts are laid out within a maximum width.
+	Wrap text.Wrap
+
 	// entries are all of the legendEntries described
 	// by this legend.
 	entries []legendEntry
@@ -96,11 +102,11 @@ func newLegend(hdlr text.Handler) Legend {
 func (l *Legend) Draw(c draw.Canvas) {
 	iconx := c.Min.X
 	sty := l.TextStyle
-	em := sty.Rectangle(" ")
-	textx := iconx + l.ThumbnailWidth + em.Max.X
+	gap := l.gap()
+	textx := iconx + l.ThumbnailWidth + gap
 	if !l.Left {
 		iconx = c.Max.X - l.ThumbnailWidth
-		textx = iconx - em.Max.X
+		textx = iconx - gap
 		sty.XAlign--
 	}
 	textx += l.XOffs
@@ -132,9 +138,10 @@ func (l *Legend) Draw(c draw.Canvas) {
 		for _, t := range e.thumbs {
 			t.Thumbnail(icon)
 		}
-		yoffs := (enth - descent - sty.Rectangle(e.text).Max.Y) / 2
+		txt := l.text(e)
+		yoffs := (enth - descent - sty.Rectangle(txt).Max.Y) / 2
 		yoffs += yoff
-		c.FillText(sty, vg.Point{X: textx, Y: icon.Min.Y + yoffs}, e.text)
+		c.FillText(sty, vg.Point{X: textx, Y: icon.Min.Y + yoffs}, txt)
 		icon.Min.Y -= enth + l.Padding
 		icon.Max.Y -= enth + l.Padding
 	}
@@ -144,9 +151,10 @@ func (l *Legend) Draw(c draw.Canvas) {
 func (l *Legend) Rectangle(c draw.Canvas) vg.Rectangle {
 	var width, height vg.Length
 	sty := l.TextStyle
+	gap := l.gap()
 	entryHeight := l.entryHeight()
 	for i, e := range l.entries {
-		width = vg.Length(math.Max(float64(width), float64(l.ThumbnailWidth+sty.Rectangle(" "+e.text).Max.X)))
+		width = vg.Length(math.Max(float64(width), float64(l.ThumbnailWidth+gap+sty.Rectangle(l.text(e)).Max.X)))
 		height += entryHeight
 		if i != 0 {
 			height += l.Padding
@@ -174,13 +182,25 @@ func (l *Legend) Rectangle(c draw.Canvas) vg.Rectangle {
 // entry text.
 func (l *Legend) entryHeight() (height vg.Length) {
 	for _, e := range l.entries {
-		if h := l.TextStyle.Rectangle(e.text).Max.Y; h > height {
+		if h := l.TextStyle.Rectangle(l.text(e)).Max.Y; h > height {
 			height = h
 		}
 	}
 	return
 }
 
+// gap returns the distance between the thumbnails
+// and the texts of the legend entries.
+func (l *Legend) gap() vg.Length {
+	return l.TextStyle.Rectangle(" ").Max.X
+}
+
+// text returns the text of the legend entry,
+// wrapped within its maximum width.
+func (l *Legend) text(e legendEntry) string {
+	return l.Wrap.Text(l.TextStyle, e.text)
+}
+
 // Add adds an entry to the legend with the given name.
 // The entry's thumbnail is drawn as the composite of all of the
 // thumbnails.
@@ -7,9 +7,31 @@ package plot_test
 import (
 	"testing"
 
+	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/text"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 )
 
 func TestLegend_standalone(t *testing.T) {
 	cmpimg.CheckPlot(ExampleLegend_standalone, t, "legend_standalone.png")
 }
+
+func TestLegendWrapWidth(t *testing.T) {
+	l := plot.NewLegend()
+	l.Wrap = text.Wrap{Width: vg.Points(150)}
+	// The second line of the wrapped entry is the widest one.
+	const entry = "short\nsomewhat longer line"
+	l.Add(entry)
+
+	c := draw.NewCanvas(new(recorder.Canvas), vg.Points(200), vg.Points(200))
+	r := l.Rectangle(c)
+
+	sty := l.TextStyle
+	want := l.ThumbnailWidth + sty.Rectangle(" ").Max.X + sty.Rectangle(entry).Max.X
+	if got := r.Size().X; got != want {
+		t.Errorf("unexpected legend width: got:%v want:%v", got, want)
+	}
+}
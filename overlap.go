@@ -0,0 +1,194 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/plot/text"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// TickOverlap specifies how the tick labels of an axis
+// are laid out when they would overlap each other.
+type TickOverlap int
+
+const (
+	// OverlapAllow draws the tick labels as they are.
+	OverlapAllow TickOverlap = iota
+
+	// OverlapRotate rotates the tick labels by an eighth
+	// of a turn counterclockwise, or by a quarter turn
+	// when an eighth of a turn is not enough. The tick
+	// labels of a vertical axis are thinned instead.
+	OverlapRotate
+
+	// OverlapStagger draws every other tick label on
+	// a second row. The tick labels of a vertical axis
+	// are thinned instead.
+	OverlapStagger
+
+	// OverlapThin only labels the tick marks at a regular
+	// stride, the smallest for which the remaining labels
+	// do not overlap. The tick marks of the dropped labels
+	// are drawn as minor tick marks.
+	OverlapThin
+
+	// OverlapAuto staggers the tick labels if this avoids
+	// the overlap, then rotates them, and thins them if
+	// they still overlap.
+	OverlapAuto
+)
+
+// avoidOverlap returns a copy of the axis with its tick marks and tick
+// label style laid out according to Tick.Overlap, such that its tick
+// labels do not overlap when the axis is drawn with the given length
+// and orientation.
+func (a Axis) avoidOverlap(length vg.Length, o orientation) Axis {
+	if a.Tick.Overlap == OverlapAllow || a.Tick.HideLabels || length <= 0 {
+		return a
+	}
+	marks := append([]Tick(nil), a.Tick.Marker.Ticks(a.Min, a.Max)...)
+	labels := a.labelled(marks, length)
+	if !overlaps(a.Tick.Label, marks, labels, o, 1) {
+		return a
+	}
+
+	strategy := a.Tick.Overlap
+	if o == vertical {
+		strategy = OverlapThin
+	}
+	rotations := []float64{math.Pi / 4, math.Pi / 2}
+	switch strategy {
+	case OverlapRotate:
+		for _, rot := range rotations {
+			a.Tick.Label = rotated(a.Tick.Label, rot)
+			if !overlaps(a.Tick.Label, marks, labels, o, 1) {
+				break
+			}
+		}
+		return a
+	case OverlapStagger:
+		return a.stagger(marks, labels)
+	case OverlapThin:
+		return a.thin(marks, labels, o)
+	case OverlapAuto:
+		if !overlaps(a.Tick.Label, marks, labels, o, 2) {
+			return a.stagger(marks, labels)
+		}
+		for _, rot := range rotations {
+			sty := rotated(a.Tick.Label, rot)
+			if !overlaps(sty, marks, labels, o, 1) {
+				a.Tick.Label = sty
+				return a
+			}
+		}
+		return a.thin(marks, labels, o)
+	default:
+		panic("plot: unknown tick overlap strategy")
+	}
+}
+
+// tickPos is the position of a labeled tick mark along an axis.
+type tickPos struct {
+	i   int // i is the index of the tick mark.
+	pos vg.Length
+}
+
+// labelled returns the positions of the labeled major tick marks
+// within the range of an axis of the given length, in increasing
+// order of position.
+func (a Axis) labelled(marks []Tick, length vg.Length) []tickPos {
+	var labels []tickPos
+	for i, t := range marks {
+		if t.IsMinor() {
+			continue
+		}
+		x := a.Norm(t.Value)
+		if x < 0 || x > 1 {
+			continue
+		}
+		labels = append(labels, tickPos{i: i, pos: vg.Length(x) * length})
+	}
+	sort.SliceStable(labels, func(i, j int) bool { return labels[i].pos < labels[j].pos })
+	return labels
+}
+
+// overlaps returns whether any pair of the labels of the marks that
+// are step apart overlap when drawn with sty along an axis with the
+// given orientation.
+func overlaps(sty text.Style, marks []Tick, labels []tickPos, o orientation, step int) bool {
+	gap := sty.Width(" ") / 2
+	for j := step; j < len(labels); j++ {
+		lo, hi := labels[j-step], labels[j]
+		d := hi.pos - lo.pos
+		if o == horizontal && sty.Rotation != 0 {
+			// Rotated labels are parallel strips whose
+			// distance depends on the rotation.
+			h := max(sty.Height(marks[lo.i].Label), sty.Height(marks[hi.i].Label))
+			if d*vg.Length(math.Abs(math.Sin(sty.Rotation))) < h {
+				return true
+			}
+			continue
+		}
+		rlo, rhi := sty.Rectangle(marks[lo.i].Label), sty.Rectangle(marks[hi.i].Label)
+		if o == horizontal {
+			if d+rhi.Min.X < rlo.Max.X+gap {
+				return true
+			}
+			continue
+		}
+		if d+rhi.Min.Y < rlo.Max.Y {
+			return true
+		}
+	}
+	return false
+}
+
+// rotated returns the tick label style sty of a horizontal
+// axis rotated by rot, with the end of the labels at their
+// tick marks.
+func rotated(sty text.Style, rot float64) text.Style {
+	sty.Rotation = rot
+	sty.XAlign = draw.XRight
+	sty.YAlign = draw.YCenter
+	return sty
+}
+
+// stagger returns a copy of the axis with every other
+// label drawn on a second row.
+func (a Axis) stagger(marks []Tick, labels []tickPos) Axis {
+	for j, l := range labels {
+		if j%2 == 1 {
+			// The empty first line moves the
+			// label to a second row, which is
+			// accounted for in the axis size.
+			marks[l.i].Label = "\n" + marks[l.i].Label
+		}
+	}
+	a.Tick.Marker = a.constantTicks(marks)
+	return a
+}
+
+// thin returns a copy of the axis with its labels dropped
+// but at the smallest regular stride for which they do not
+// overlap.
+func (a Axis) thin(marks []Tick, labels []tickPos, o orientation) Axis {
+	stride := 2
+	for ; stride < len(labels); stride++ {
+		if !overlaps(a.Tick.Label, marks, labels, o, stride) {
+			break
+		}
+	}
+	for j, l := range labels {
+		if j%stride != 0 {
+			marks[l.i].Label = ""
+		}
+	}
+	a.Tick.Marker = a.constantTicks(marks)
+	return a
+}
@@ -0,0 +1,51 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExampleTickOverlap draws bar charts with long nominal tick labels,
+// laid out with the different strategies avoiding their overlap.
+func ExampleTickOverlap() {
+	months := []string{
+		"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December",
+	}
+	values := make(plotter.Values, len(months))
+	for i := range values {
+		values[i] = 10 + 8*math.Sin(2*math.Pi*float64(i)/float64(len(months)))
+	}
+
+	newPlot := func(title string, overlap plot.TickOverlap) *plot.Plot {
+		bars, err := plotter.NewBarChart(values, vg.Points(8))
+		if err != nil {
+			log.Panic(err)
+		}
+		p := plot.New()
+		p.Title.Text = title
+		p.Add(bars)
+		p.NominalX(months...)
+		p.X.Tick.Overlap = overlap
+		return p
+	}
+
+	fig := plot.NewFigure(2, 2)
+	fig.Add(newPlot("OverlapAllow", plot.OverlapAllow), 0, 0)
+	fig.Add(newPlot("OverlapRotate", plot.OverlapRotate), 0, 1)
+	fig.Add(newPlot("OverlapStagger", plot.OverlapStagger), 1, 0)
+	fig.Add(newPlot("OverlapThin", plot.OverlapThin), 1, 1)
+
+	err := fig.Save(500, 350, "testdata/tick_overlap.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestTickOverlap(t *testing.T) {
+	cmpimg.CheckPlot(ExampleTickOverlap, t, "tick_overlap.png")
+}
+
+func TestTickOverlapSize(t *testing.T) {
+	names := make([]string, 20)
+	for i := range names {
+		names[i] = fmt.Sprintf("label %d", i)
+	}
+	bottom := func(w vg.Length, overlap plot.TickOverlap) vg.Length {
+		c := draw.NewCanvas(new(recorder.Canvas), w, vg.Points(200))
+		p := plot.New()
+		p.NominalX(names...)
+		p.X.Min, p.X.Max = 0, float64(len(names)-1)
+		p.Y.Min, p.Y.Max = 0, 1
+		p.X.Tick.Overlap = overlap
+		return p.DataCanvas(c).Min.Y
+	}
+
+	allow := bottom(200, plot.OverlapAllow)
+	for _, overlap := range []plot.TickOverlap{
+		plot.OverlapAllow,
+		plot.OverlapRotate,
+		plot.OverlapStagger,
+		plot.OverlapThin,
+		plot.OverlapAuto,
+	} {
+		if got := bottom(2000, overlap); got != allow {
+			t.Errorf("unexpected axis size without overlap for %d: got:%v want:%v", overlap, got, allow)
+		}
+	}
+	if got := bottom(200, plot.OverlapRotate); got <= allow {
+		t.Errorf("rotated tick labels reserve no space: got:%v allow:%v", got, allow)
+	}
+	if got := bottom(200, plot.OverlapStagger); got <= allow {
+		t.Errorf("staggered tick labels reserve no space: got:%v allow:%v", got, allow)
+	}
+	if got := bottom(200, plot.OverlapThin); got != allow {
+		t.Errorf("unexpected axis size for thinned tick labels: got:%v want:%v", got, allow)
+	}
+}
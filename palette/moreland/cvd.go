@@ -0,0 +1,270 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package moreland
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot/palette"
+)
+
+// Deficiency is a type of color vision deficiency.
+type Deficiency int
+
+const (
+	// NormalVision is trichromatic color vision.
+	// Colors are left unchanged by its simulation.
+	NormalVision Deficiency = iota
+
+	// Protanopia is the absence of the long wavelength
+	// sensitive cones, which makes reds appear dark and
+	// confuses them with greens.
+	Protanopia
+
+	// Deuteranopia is the absence of the medium wavelength
+	// sensitive cones, the most common deficiency, which
+	// confuses reds and greens.
+	Deuteranopia
+
+	// Tritanopia is the absence of the short wavelength
+	// sensitive cones, which confuses blues and greens,
+	// and yellows and violets.
+	Tritanopia
+)
+
+// Deficiencies are the simulated color vision deficiencies.
+var Deficiencies = []Deficiency{Protanopia, Deuteranopia, Tritanopia}
+
+// String implements the fmt.Stringer interface.
+func (d Deficiency) String() string {
+	switch d {
+	case NormalVision:
+		return "normal vision"
+	case Protanopia:
+		return "protanopia"
+	case Deuteranopia:
+		return "deuteranopia"
+	case Tritanopia:
+		return "tritanopia"
+	default:
+		return fmt.Sprintf("Deficiency(%d)", int(d))
+	}
+}
+
+// deficiencyMatrices are the matrices of the model of Machado, Oliveira
+// and Fernandes for the complete deficiencies, transforming linear RGB
+// colors to the colors perceived by dichromats.
+//
+// "A Physiologically-based Model for Simulation of Color Vision Deficiency."
+// Gustavo M. Machado, Manuel M. Oliveira and Leandro A. F. Fernandes.
+// IEEE Transactions on Visualization and Computer Graphics, 15(6), 2009.
+var deficiencyMatrices = map[Deficiency][3][3]float64{
+	Protanopia: {
+		{0.152286, 1.052583, -0.204868},
+		{0.114503, 0.786281, 0.099216},
+		{-0.003882, -0.048116, 1.051998},
+	},
+	Deuteranopia: {
+		{0.367322, 0.860646, -0.227968},
+		{0.280085, 0.672501, 0.047413},
+		{-0.011820, 0.042940, 0.968881},
+	},
+	Tritanopia: {
+		{1.255528, -0.076749, -0.178779},
+		{-0.078411, 0.930809, 0.147602},
+		{0.004733, 0.691367, 0.303900},
+	},
+}
+
+// simulate returns the color c as perceived with the deficiency d.
+func (d Deficiency) simulate(c sRGBA) sRGBA {
+	if d == NormalVision {
+		return c
+	}
+	m, ok := deficiencyMatrices[d]
+	if !ok {
+		panic(fmt.Sprintf("moreland: unknown color vision deficiency %d", int(d)))
+	}
+	lin := c.rgb()
+	sim := rgb{
+		R: m[0][0]*lin.R + m[0][1]*lin.G + m[0][2]*lin.B,
+		G: m[1][0]*lin.R + m[1][1]*lin.G + m[1][2]*lin.B,
+		B: m[2][0]*lin.R + m[2][1]*lin.G + m[2][2]*lin.B,
+	}.sRGBA(c.A)
+	sim.clamp()
+	return sim
+}
+
+// Simulate returns the color c as perceived by a viewer with the
+// color vision deficiency d, using the model of Machado et al. for
+// complete dichromacy. The opacity of c is kept. Simulate panics
+// if d is not a known Deficiency.
+func Simulate(c color.Color, d Deficiency) color.Color {
+	return nrgba(d.simulate(colorTosRGBA(c)))
+}
+
+// SimulatePalette returns a palette holding the colors of p
+// as perceived by a viewer with the color vision deficiency d.
+func SimulatePalette(p palette.Palette, d Deficiency) palette.Palette {
+	colors := p.Colors()
+	sim := make([]color.Color, len(colors))
+	for i, c := range colors {
+		sim[i] = Simulate(c, d)
+	}
+	return plte(sim)
+}
+
+// SimulateImage returns the image img, such as a figure rendered
+// by a vgimg.Canvas, as perceived by a viewer with the color vision
+// deficiency d.
+func SimulateImage(img image.Image, d Deficiency) *image.NRGBA {
+	b := img.Bounds()
+	dst := image.NewNRGBA(b)
+
+	// Figures hold few distinct colors, so the
+	// simulated colors are only computed once.
+	cache := make(map[color.NRGBA]color.NRGBA)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			sim, ok := cache[c]
+			if !ok {
+				sim = nrgba(d.simulate(colorTosRGBA(c)))
+				cache[c] = sim
+			}
+			dst.SetNRGBA(x, y, sim)
+		}
+	}
+	return dst
+}
+
+// nrgba returns the 8-bit non-premultiplied color of c.
+func nrgba(c sRGBA) color.NRGBA {
+	u8 := func(v float64) uint8 { return uint8(math.Round(v * 0xff)) }
+	return color.NRGBA{R: u8(c.R), G: u8(c.G), B: u8(c.B), A: u8(c.A)}
+}
+
+// ColorDistance returns the CIEDE2000 color difference between
+// the colors a and b, ignoring their opacity. A difference of about
+// one is just noticeable, and colors meant to be distinguished in a
+// figure should differ by at least ten or so.
+//
+// "The CIEDE2000 Color-Difference Formula: Implementation Notes,
+// Supplementary Test Data, and Mathematical Observations."
+// Gaurav Sharma, Wencheng Wu and Edul N. Dalal.
+// Color Research & Application, 30(1), 2005.
+func ColorDistance(a, b color.Color) float64 {
+	return ciede2000(colorTosRGBA(a).cieLAB(), colorTosRGBA(b).cieLAB())
+}
+
+// MinDistance returns the minimum CIEDE2000 color difference between
+// pairs of colors of the palette p as perceived by a viewer with the
+// color vision deficiency d, and the indices i < j of the closest
+// pair of colors. MinDistance returns +Inf and -1, -1 if p has fewer
+// than two colors.
+//
+// MinDistance can be used to reject palettes whose colors cannot be
+// told apart by color blind viewers:
+//
+//	for _, d := range moreland.Deficiencies {
+//		if dist, i, j := moreland.MinDistance(p, d); dist < 10 {
+//			t.Errorf("colors %d and %d are too close with %v: %.1f", i, j, d, dist)
+//		}
+//	}
+func MinDistance(p palette.Palette, d Deficiency) (dist float64, i, j int) {
+	colors := p.Colors()
+	labs := make([]cieLAB, len(colors))
+	for k, c := range colors {
+		labs[k] = d.simulate(colorTosRGBA(c)).cieLAB()
+	}
+	dist, i, j = math.Inf(1), -1, -1
+	for k := range labs {
+		for l := k + 1; l < len(labs); l++ {
+			if v := ciede2000(labs[k], labs[l]); v < dist {
+				dist, i, j = v, k, l
+			}
+		}
+	}
+	return dist, i, j
+}
+
+// ciede2000 returns the CIEDE2000 color difference between
+// c1 and c2, with unit parametric weighting factors.
+func ciede2000(c1, c2 cieLAB) float64 {
+	const pow25to7 = 6103515625 // 25^7
+
+	deg := func(rad float64) float64 { return rad * 180 / math.Pi }
+	rad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	// Adjust the a* axis for the low chroma
+	// of neutral colors.
+	cBar := (math.Hypot(c1.A, c1.B) + math.Hypot(c2.A, c2.B)) / 2
+	cBar7 := math.Pow(cBar, 7)
+	g := 0.5 * (1 - math.Sqrt(cBar7/(cBar7+pow25to7)))
+	a1, a2 := (1+g)*c1.A, (1+g)*c2.A
+
+	// hue returns the hue angle of (a, b) in degrees in [0, 360).
+	hue := func(a, b float64) float64 {
+		if a == 0 && b == 0 {
+			return 0
+		}
+		h := deg(math.Atan2(b, a))
+		if h < 0 {
+			h += 360
+		}
+		return h
+	}
+	cp1, cp2 := math.Hypot(a1, c1.B), math.Hypot(a2, c2.B)
+	hp1, hp2 := hue(a1, c1.B), hue(a2, c2.B)
+
+	dL := c2.L - c1.L
+	dC := cp2 - cp1
+	var dh float64
+	if cp1*cp2 != 0 {
+		dh = hp2 - hp1
+		switch {
+		case dh > 180:
+			dh -= 360
+		case dh < -180:
+			dh += 360
+		}
+	}
+	dH := 2 * math.Sqrt(cp1*cp2) * math.Sin(rad(dh)/2)
+
+	lBar := (c1.L + c2.L) / 2
+	cpBar := (cp1 + cp2) / 2
+	hBar := hp1 + hp2
+	if cp1*cp2 != 0 {
+		switch {
+		case math.Abs(hp1-hp2) <= 180:
+			hBar /= 2
+		case hBar < 360:
+			hBar = (hBar + 360) / 2
+		default:
+			hBar = (hBar - 360) / 2
+		}
+	}
+
+	t := 1 - 0.17*math.Cos(rad(hBar-30)) +
+		0.24*math.Cos(rad(2*hBar)) +
+		0.32*math.Cos(rad(3*hBar+6)) -
+		0.20*math.Cos(rad(4*hBar-63))
+	dTheta := 30 * math.Exp(-math.Pow((hBar-275)/25, 2))
+	cpBar7 := math.Pow(cpBar, 7)
+	rC := 2 * math.Sqrt(cpBar7/(cpBar7+pow25to7))
+	l50 := (lBar - 50) * (lBar - 50)
+	sL := 1 + 0.015*l50/math.Sqrt(20+l50)
+	sC := 1 + 0.045*cpBar
+	sH := 1 + 0.015*cpBar*t
+	rT := -math.Sin(rad(2*dTheta)) * rC
+
+	dL /= sL
+	dC /= sC
+	dH /= sH
+	return math.Sqrt(dL*dL + dC*dC + dH*dH + rT*dC*dH)
+}
@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package moreland
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
+)
+
+func TestCIEDE2000(t *testing.T) {
+	// Test data from Sharma et al., Table 1.
+	for i, test := range []struct {
+		c1, c2 cieLAB
+		want   float64
+	}{
+		{c1: cieLAB{50, 2.6772, -79.7751}, c2: cieLAB{50, 0, -82.7485}, want: 2.0425},
+		{c1: cieLAB{50, 0, 0}, c2: cieLAB{50, -1, 2}, want: 2.3669},
+		{c1: cieLAB{50, 2.49, -0.001}, c2: cieLAB{50, -2.49, 0.0009}, want: 7.1792},
+		{c1: cieLAB{50, 2.49, -0.001}, c2: cieLAB{50, -2.49, 0.0011}, want: 7.2195},
+		{c1: cieLAB{50, 2.5, 0}, c2: cieLAB{73, 25, -18}, want: 27.1492},
+		{c1: cieLAB{50, 2.5, 0}, c2: cieLAB{61, -5, 29}, want: 22.8977},
+		{c1: cieLAB{50, 2.5, 0}, c2: cieLAB{56, -27, -3}, want: 31.9030},
+		{c1: cieLAB{50, 2.5, 0}, c2: cieLAB{58, 24, 15}, want: 19.4535},
+		{c1: cieLAB{60.2574, -34.0099, 36.2677}, c2: cieLAB{60.4626, -34.1751, 39.4387}, want: 1.2644},
+		{c1: cieLAB{2.0776, 0.0795, -1.135}, c2: cieLAB{0.9033, -0.0636, -0.5514}, want: 0.9082},
+	} {
+		for _, pair := range [][2]cieLAB{{test.c1, test.c2}, {test.c2, test.c1}} {
+			got := ciede2000(pair[0], pair[1])
+			if !scalar.EqualWithinAbs(got, test.want, 1e-4) {
+				t.Errorf("unexpected difference for test %d: got=%.4f, want=%.4f", i, got, test.want)
+			}
+		}
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	red := color.NRGBA{R: 0xd6, G: 0x27, B: 0x28, A: 0xff}
+	green := color.NRGBA{R: 0x2c, G: 0xa0, B: 0x2c, A: 0xff}
+	for _, d := range append([]Deficiency{NormalVision}, Deficiencies...) {
+		// Dichromats see neutral colors unchanged.
+		for _, c := range []color.NRGBA{
+			{A: 0xff},
+			{R: 0x80, G: 0x80, B: 0x80, A: 0xff},
+			{R: 0xff, G: 0xff, B: 0xff, A: 0x80},
+		} {
+			got := Simulate(c, d).(color.NRGBA)
+			if !near(got, c, 1) {
+				t.Errorf("unexpected %v color of %v: got=%v", d, c, got)
+			}
+		}
+	}
+
+	normal := ColorDistance(red, green)
+	if got := ColorDistance(Simulate(red, NormalVision), Simulate(green, NormalVision)); got != normal {
+		t.Errorf("unexpected difference of red and green with normal vision: got=%.1f, want=%.1f", got, normal)
+	}
+	for _, d := range []Deficiency{Protanopia, Deuteranopia} {
+		// Red and green are confused by the deficiency.
+		if got := ColorDistance(Simulate(red, d), Simulate(green, d)); got > normal/2 {
+			t.Errorf("red and green too far with %v: got=%.1f, normal=%.1f", d, got, normal)
+		}
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("expected a panic for an unknown deficiency")
+			}
+		}()
+		Simulate(red, Deficiency(-1))
+	}()
+}
+
+func near(a, b color.NRGBA, tol int) bool {
+	diff := func(a, b uint8) bool {
+		d := int(a) - int(b)
+		return -tol <= d && d <= tol
+	}
+	return diff(a.R, b.R) && diff(a.G, b.G) && diff(a.B, b.B) && diff(a.A, b.A)
+}
+
+func TestMinDistance(t *testing.T) {
+	redGreen := plte{
+		color.NRGBA{R: 0xd6, G: 0x27, B: 0x28, A: 0xff},
+		color.NRGBA{R: 0x2c, G: 0xa0, B: 0x2c, A: 0xff},
+		color.NRGBA{R: 0x1f, G: 0x77, B: 0xb4, A: 0xff},
+	}
+	blueTan := SmoothBlueTan().Palette(5)
+	for _, d := range Deficiencies {
+		if dist, _, _ := MinDistance(blueTan, d); dist < 10 {
+			t.Errorf("unexpected minimum distance of the blue-tan palette with %v: %.1f", d, dist)
+		}
+	}
+
+	dist, i, j := MinDistance(redGreen, NormalVision)
+	if dist < 40 {
+		t.Errorf("unexpected minimum distance with normal vision: %.1f between %d and %d", dist, i, j)
+	}
+	dist, i, j = MinDistance(redGreen, Deuteranopia)
+	if dist > 20 || i != 0 || j != 1 {
+		t.Errorf("unexpected minimum distance with deuteranopia: %.1f between %d and %d", dist, i, j)
+	}
+
+	dist, i, j = MinDistance(redGreen[:1], Deuteranopia)
+	if !math.IsInf(dist, 1) || i != -1 || j != -1 {
+		t.Errorf("unexpected minimum distance of a single color: %v between %d and %d", dist, i, j)
+	}
+
+	sim := SimulatePalette(redGreen, Deuteranopia).Colors()
+	if len(sim) != len(redGreen) {
+		t.Fatalf("unexpected number of simulated colors: got=%d, want=%d", len(sim), len(redGreen))
+	}
+	for k, c := range sim {
+		if want := Simulate(redGreen[k], Deuteranopia); c != want {
+			t.Errorf("unexpected simulated color %d: got=%v, want=%v", k, c, want)
+		}
+	}
+}
+
+func TestSimulateImage(t *testing.T) {
+	red := color.NRGBA{R: 0xd6, G: 0x27, B: 0x28, A: 0xff}
+	cnv := vgimg.New(2*vg.Inch, vg.Inch)
+	dc := draw.New(cnv)
+	dc.SetColor(red)
+	dc.Fill(dc.Rectangle.Path())
+
+	img := SimulateImage(cnv.Image(), Protanopia)
+	if got, want := img.Bounds(), cnv.Image().Bounds(); got != want {
+		t.Fatalf("unexpected bounds: got=%v, want=%v", got, want)
+	}
+	want := Simulate(red, Protanopia)
+	for _, pt := range []image.Point{img.Bounds().Min, img.Bounds().Max.Sub(image.Pt(1, 1))} {
+		if got := img.At(pt.X, pt.Y); got != want {
+			t.Errorf("unexpected color at %v: got=%v, want=%v", pt, got, want)
+		}
+	}
+}
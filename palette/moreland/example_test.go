@@ -142,3 +142,66 @@ func Example() {
 func TestHeatMap(t *testing.T) {
 	cmpimg.CheckPlot(Example, t, "moreland.png")
 }
+
+// This Example shows a heat map using the SmoothGreenRed palette as
+// perceived by viewers with normal color vision and with each of
+// the simulated color vision deficiencies.
+// The output can be found at
+// https://github.com/gonum/plot/blob/master/palette/moreland/testdata/simulate_golden.png.
+func ExampleSimulateImage() {
+	m := offsetUnitGrid{
+		XOffset: -50,
+		YOffset: -50,
+		Data:    mat.NewDense(100, 100, nil),
+	}
+	for i := range 100 {
+		for j := range 100 {
+			x := float64(i-50) / 10
+			y := float64(j-50) / 10
+			v := math.Sin(x*x+y*y) / (x*x + y*y)
+			m.Data.Set(i, j, v)
+		}
+	}
+
+	const (
+		rows = 2
+		cols = 2
+		size = 300
+	)
+	c := vgimg.New(vg.Points(cols*size), vg.Points(rows*size))
+	dc := draw.New(c)
+	tiles := draw.Tiles{
+		Rows: rows,
+		Cols: cols,
+	}
+	deficiencies := append([]moreland.Deficiency{moreland.NormalVision}, moreland.Deficiencies...)
+	for i, d := range deficiencies {
+		p := plot.New()
+		p.Title.Text = d.String()
+		p.Add(plotter.NewHeatMap(m, moreland.SmoothGreenRed().Palette(255)))
+		p.X.Padding = 0
+		p.Y.Padding = 0
+
+		// Render the plot and draw it as perceived
+		// with the deficiency.
+		tile := vgimg.New(vg.Points(size), vg.Points(size))
+		p.Draw(draw.New(tile))
+		img := moreland.SimulateImage(tile.Image(), d)
+
+		tc := tiles.At(dc, i%cols, i/cols)
+		tc.DrawImage(tc.Rectangle, img)
+	}
+
+	pngimg := vgimg.PngCanvas{Canvas: c}
+	f, err := os.Create("testdata/simulate.png")
+	if err != nil {
+		log.Panic(err)
+	}
+	if _, err = pngimg.WriteTo(f); err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestSimulateImagePlot(t *testing.T) {
+	cmpimg.CheckPlot(ExampleSimulateImage, t, "simulate.png")
+}
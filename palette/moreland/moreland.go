@@ -20,4 +20,9 @@
 // "Diverging Color Maps for Scientific Visualization." Kenneth Moreland.
 // In Proceedings of the 5th International Symposium on Visual Computing,
 // December 2009. DOI 10.1007/978-3-642-10520-3_9.
+//
+// The package also simulates color vision deficiencies on colors,
+// palettes and rendered images, and measures CIEDE2000 color
+// differences, so that palettes can be checked for color blind
+// readers.
 package moreland // import "gonum.org/v1/plot/palette/moreland"
@@ -0,0 +1,270 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package palette
+
+import (
+	"image/color"
+	"math"
+	"slices"
+	"sort"
+)
+
+// Normalizer maps values to positions along a ColorMap or a Palette.
+// It has the method set of plot.Normalizer, so that a Normalizer can
+// be used as the scale of the axis of a color bar.
+type Normalizer interface {
+	// Normalize returns the position of v between min and max,
+	// which is within [0, 1] when v is within the normalized
+	// range, below zero when v is below it and above one when
+	// v is above it. Values that cannot be normalized are
+	// mapped to NaN.
+	Normalize(min, max, v float64) float64
+}
+
+var (
+	_ Normalizer = LinearNorm{}
+	_ Normalizer = LogNorm{}
+	_ Normalizer = SymLogNorm{}
+	_ Normalizer = PowerNorm{}
+	_ Normalizer = BoundaryNorm{}
+	_ Normalizer = TwoSlopeNorm{}
+	_ Normalizer = EqHistNorm{}
+)
+
+// LinearNorm maps values linearly between min and max.
+type LinearNorm struct{}
+
+// Normalize returns the fractional distance of v between min and max.
+func (LinearNorm) Normalize(min, max, v float64) float64 {
+	return (v - min) / (max - min)
+}
+
+// LogNorm maps the logarithm of values linearly between the
+// logarithms of min and max. Non-positive values, and all values
+// when min or max is not positive, are mapped to NaN.
+type LogNorm struct{}
+
+// Normalize returns the fractional logarithmic distance of v between
+// min and max.
+func (LogNorm) Normalize(min, max, v float64) float64 {
+	if min <= 0 || max <= 0 || v <= 0 {
+		return math.NaN()
+	}
+	lmin := math.Log(min)
+	return (math.Log(v) - lmin) / (math.Log(max) - lmin)
+}
+
+// SymLogNorm maps values with a symmetrical logarithm, which is
+// linear around zero and logarithmic away from it, so that values of
+// both signs spanning several orders of magnitude can be shown.
+type SymLogNorm struct {
+	// LinThresh is the positive threshold of the range around zero
+	// where values are mapped linearly. Each decade beyond the
+	// threshold takes the same room as the linear range on each
+	// side of zero. If LinThresh is zero, a threshold of 1 is used.
+	LinThresh float64
+}
+
+// Normalize returns the fractional symmetrical logarithmic distance of
+// v between min and max.
+func (n SymLogNorm) Normalize(min, max, v float64) float64 {
+	tmin := n.transform(min)
+	return (n.transform(v) - tmin) / (n.transform(max) - tmin)
+}
+
+// transform returns the symmetrical logarithm of v.
+func (n SymLogNorm) transform(v float64) float64 {
+	th := n.LinThresh
+	if th == 0 {
+		th = 1
+	}
+	a := math.Abs(v)
+	if a <= th {
+		return v / th
+	}
+	return math.Copysign(1+math.Log10(a/th), v)
+}
+
+// PowerNorm maps values linearly between min and max, and raises
+// the positions within [0, 1] to the power Gamma. A Gamma below one
+// spreads the colors of the low values, and a Gamma above one those
+// of the high values.
+type PowerNorm struct {
+	// Gamma is the exponent of the power law.
+	// If Gamma is zero, an exponent of 1 is used.
+	Gamma float64
+}
+
+// Normalize returns the fractional distance of v between min and max
+// raised to the power Gamma.
+func (n PowerNorm) Normalize(min, max, v float64) float64 {
+	x := (v - min) / (max - min)
+	if x < 0 || x > 1 || n.Gamma == 0 {
+		return x
+	}
+	return math.Pow(x, n.Gamma)
+}
+
+// BoundaryNorm maps values to discrete positions according to the
+// interval of Boundaries they fall in, ignoring the normalized range.
+// With n intervals, values in the i-th interval are mapped to the
+// position i/(n-1), so that a Palette of n colors draws each interval
+// with its own color, and a ColorMap is sampled at n evenly spaced
+// values. Values below the first boundary are mapped to -Inf and
+// values above the last one to +Inf.
+type BoundaryNorm struct {
+	// Boundaries are the increasing boundaries of the intervals.
+	// Each interval includes its lower boundary, and the last
+	// interval includes the last boundary.
+	Boundaries []float64
+}
+
+// Normalize returns the position of the interval of Boundaries
+// holding v.
+func (n BoundaryNorm) Normalize(_, _, v float64) float64 {
+	b := n.Boundaries
+	switch {
+	case len(b) < 2 || math.IsNaN(v):
+		return math.NaN()
+	case v < b[0]:
+		return math.Inf(-1)
+	case v > b[len(b)-1]:
+		return math.Inf(+1)
+	}
+	bins := len(b) - 1
+	if bins == 1 {
+		return 0.5
+	}
+	i := sort.SearchFloat64s(b, v)
+	if i == len(b) || b[i] != v {
+		i--
+	}
+	i = min(i, bins-1)
+	return float64(i) / float64(bins-1)
+}
+
+// TwoSlopeNorm maps values linearly with different slopes on
+// each side of Center, so that Center is mapped to the middle of a
+// diverging ColorMap or Palette whatever the normalized range.
+type TwoSlopeNorm struct {
+	// Center is the value mapped to 0.5. Center must be
+	// within the normalized range; values are mapped to
+	// NaN otherwise.
+	Center float64
+}
+
+// Normalize returns the position of v between min and max, with
+// min, Center and max mapped to 0, 0.5 and 1.
+func (n TwoSlopeNorm) Normalize(min, max, v float64) float64 {
+	if n.Center <= min || n.Center >= max {
+		return math.NaN()
+	}
+	if v < n.Center {
+		return 0.5 * (v - min) / (n.Center - min)
+	}
+	return 0.5 + 0.5*(v-n.Center)/(max-n.Center)
+}
+
+// EqHistNorm maps values according to their rank amongst the
+// Values within the normalized range, equalizing the histogram
+// of the colors of these values. Values below min are mapped
+// to -Inf and values above max to +Inf.
+type EqHistNorm struct {
+	// Values are the increasing distinct values whose
+	// ranks give the positions, as returned by NewEqHistNorm.
+	Values []float64
+}
+
+// NewEqHistNorm returns an EqHistNorm equalizing the histogram of
+// the colors of the given values. NaN values are ignored.
+func NewEqHistNorm(values []float64) EqHistNorm {
+	vs := make([]float64, 0, len(values))
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			vs = append(vs, v)
+		}
+	}
+	slices.Sort(vs)
+	return EqHistNorm{Values: slices.Compact(vs)}
+}
+
+// Normalize returns the rank of v amongst the Values between min and
+// max, divided by the number of these Values less one. The position
+// of v is one when there is a single such value.
+func (n EqHistNorm) Normalize(min, max, v float64) float64 {
+	switch {
+	case math.IsNaN(v):
+		return math.NaN()
+	case v < min:
+		return math.Inf(-1)
+	case v > max:
+		return math.Inf(+1)
+	}
+	lo := sort.SearchFloat64s(n.Values, min)
+	hi := sort.SearchFloat64s(n.Values, math.Nextafter(max, math.Inf(+1)))
+	if hi-lo <= 1 {
+		return 1
+	}
+	i := sort.SearchFloat64s(n.Values, v)
+	if i == hi {
+		// v is above the last value.
+		i--
+	}
+	return float64(i-lo) / float64(hi-lo-1)
+}
+
+// ColorAt returns the color of c at the position x along its range,
+// where zero is the minimum and one the maximum of the range. The
+// position is clamped to [0, 1], which avoids overflows due to
+// floating point error.
+func ColorAt(c ColorMap, x float64) (color.Color, error) {
+	min, max := c.Min(), c.Max()
+	return c.At(math.Max(min, math.Min(min+x*(max-min), max)))
+}
+
+// NormalizedColorMap is a ColorMap mapping values to colors
+// through a Normalizer.
+type NormalizedColorMap interface {
+	ColorMap
+
+	// Normalizer returns the Normalizer of the ColorMap.
+	Normalizer() Normalizer
+}
+
+// Normalize returns a ColorMap mapping values to the colors of c
+// at the positions given by the Normalizer n over the range of c.
+// The range, the opacity and the palettes of the returned ColorMap
+// are those of c, the colors of its palettes being evenly spaced
+// along the normalized positions.
+func Normalize(c ColorMap, n Normalizer) NormalizedColorMap {
+	return normalized{ColorMap: c, norm: n}
+}
+
+// normalized is a ColorMap that normalizes values
+// with a Normalizer before mapping them to colors.
+type normalized struct {
+	ColorMap
+	norm Normalizer
+}
+
+// At implements the ColorMap interface for a normalized ColorMap.
+func (c normalized) At(v float64) (color.Color, error) {
+	min, max := c.Min(), c.Max()
+	x := c.norm.Normalize(min, max, v)
+	switch {
+	case math.IsNaN(v), math.IsNaN(x):
+		return nil, ErrNaN
+	case x < 0:
+		return nil, ErrUnderflow
+	case x > 1:
+		return nil, ErrOverflow
+	}
+	return ColorAt(c.ColorMap, x)
+}
+
+// Normalizer implements the NormalizedColorMap interface.
+func (c normalized) Normalizer() Normalizer {
+	return c.norm
+}
@@ -0,0 +1,218 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package palette
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestNormalizers(t *testing.T) {
+	var (
+		nan = math.NaN()
+		inf = math.Inf(1)
+	)
+	for _, test := range []struct {
+		name     string
+		norm     Normalizer
+		min, max float64
+		vs, want []float64
+	}{
+		{
+			name: "linear",
+			norm: LinearNorm{},
+			min:  -2, max: 2,
+			vs:   []float64{-3, -2, 0, 1, 2, 3},
+			want: []float64{-0.25, 0, 0.5, 0.75, 1, 1.25},
+		},
+		{
+			name: "log",
+			norm: LogNorm{},
+			min:  1, max: 1000,
+			vs:   []float64{0, 0.1, 1, 10, 100, 1000, 1e4},
+			want: []float64{nan, -1.0 / 3, 0, 1.0 / 3, 2.0 / 3, 1, 4.0 / 3},
+		},
+		{
+			name: "log_invalid_range",
+			norm: LogNorm{},
+			min:  0, max: 1000,
+			vs:   []float64{10},
+			want: []float64{nan},
+		},
+		{
+			name: "symlog",
+			norm: SymLogNorm{LinThresh: 1},
+			min:  -100, max: 100,
+			vs:   []float64{-100, -10, -1, 0, 0.5, 1, 10, 100},
+			want: []float64{0, 1.0 / 6, 1.0 / 3, 0.5, 7.0 / 12, 2.0 / 3, 5.0 / 6, 1},
+		},
+		{
+			name: "symlog_default_threshold",
+			norm: SymLogNorm{},
+			min:  0, max: 100,
+			vs:   []float64{0, 1, 10},
+			want: []float64{0, 1.0 / 3, 2.0 / 3},
+		},
+		{
+			name: "power",
+			norm: PowerNorm{Gamma: 2},
+			min:  0, max: 10,
+			vs:   []float64{-5, 0, 5, 10, 15},
+			want: []float64{-0.5, 0, 0.25, 1, 1.5},
+		},
+		{
+			name: "power_zero_gamma",
+			norm: PowerNorm{},
+			min:  0, max: 10,
+			vs:   []float64{5},
+			want: []float64{0.5},
+		},
+		{
+			name: "boundary",
+			norm: BoundaryNorm{Boundaries: []float64{0, 1, 10, 100}},
+			vs:   []float64{-1, 0, 0.5, 1, 50, 100, 101, nan},
+			want: []float64{-inf, 0, 0, 0.5, 1, 1, inf, nan},
+		},
+		{
+			name: "boundary_single_interval",
+			norm: BoundaryNorm{Boundaries: []float64{0, 1}},
+			vs:   []float64{0, 1},
+			want: []float64{0.5, 0.5},
+		},
+		{
+			name: "boundary_empty",
+			norm: BoundaryNorm{},
+			vs:   []float64{0},
+			want: []float64{nan},
+		},
+		{
+			name: "two_slope",
+			norm: TwoSlopeNorm{Center: 0},
+			min:  -1, max: 4,
+			vs:   []float64{-2, -1, -0.5, 0, 2, 4, 8},
+			want: []float64{-0.5, 0, 0.25, 0.5, 0.75, 1, 1.5},
+		},
+		{
+			name: "two_slope_center_out_of_range",
+			norm: TwoSlopeNorm{Center: 5},
+			min:  -1, max: 4,
+			vs:   []float64{0},
+			want: []float64{nan},
+		},
+		{
+			name: "eq_hist",
+			norm: NewEqHistNorm([]float64{100, 1, nan, 2, 2, 10, 1000}),
+			min:  1, max: 100,
+			vs:   []float64{0, 1, 2, 5, 10, 100, 101, nan},
+			want: []float64{-inf, 0, 1.0 / 3, 2.0 / 3, 2.0 / 3, 1, inf, nan},
+		},
+		{
+			name: "eq_hist_single_value",
+			norm: NewEqHistNorm([]float64{5, 5}),
+			min:  0, max: 10,
+			vs:   []float64{0, 5, 10},
+			want: []float64{1, 1, 1},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			for i, v := range test.vs {
+				got := test.norm.Normalize(test.min, test.max, v)
+				want := test.want[i]
+				if !scalar.EqualWithinAbs(got, want, 1e-12) && !(math.IsNaN(got) && math.IsNaN(want)) {
+					t.Errorf("unexpected normalized value of %v: got=%v, want=%v", v, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	gray := &grayMap{}
+	cmap := Normalize(gray, LogNorm{})
+	cmap.SetMin(1)
+	cmap.SetMax(100)
+	if gray.Min() != 1 || gray.Max() != 100 {
+		t.Errorf("range not set on the normalized color map: [%v, %v]", gray.Min(), gray.Max())
+	}
+	if _, ok := cmap.Normalizer().(LogNorm); !ok {
+		t.Errorf("unexpected normalizer: %T", cmap.Normalizer())
+	}
+
+	for _, test := range []struct {
+		v    float64
+		want color.Color
+		err  error
+	}{
+		{v: 1, want: color.Gray{Y: 0}},
+		{v: 10, want: color.Gray{Y: 127}},
+		{v: 100, want: color.Gray{Y: 255}},
+		{v: 0.5, err: ErrUnderflow},
+		{v: 200, err: ErrOverflow},
+		{v: -1, err: ErrNaN},
+		{v: math.NaN(), err: ErrNaN},
+	} {
+		got, err := cmap.At(test.v)
+		if !errors.Is(err, test.err) {
+			t.Errorf("unexpected error for %v: got=%v, want=%v", test.v, err, test.err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("unexpected color for %v: got=%v, want=%v", test.v, got, test.want)
+		}
+	}
+}
+
+func TestColorAt(t *testing.T) {
+	// The range of cmap is such that min+1*(max-min) overflows max.
+	cmap := &grayMap{min: 0.3, max: 0.9}
+	for _, test := range []struct {
+		x    float64
+		want color.Color
+	}{
+		{x: -1, want: color.Gray{Y: 0}},
+		{x: 0, want: color.Gray{Y: 0}},
+		{x: 0.5, want: color.Gray{Y: 127}},
+		{x: 1, want: color.Gray{Y: 255}},
+		{x: 2, want: color.Gray{Y: 255}},
+	} {
+		got, err := ColorAt(cmap, test.x)
+		if err != nil {
+			t.Errorf("unexpected error for %v: %v", test.x, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("unexpected color for %v: got=%v, want=%v", test.x, got, test.want)
+		}
+	}
+}
+
+// grayMap is a ColorMap going linearly from black to white.
+type grayMap struct {
+	min, max float64
+}
+
+func (m *grayMap) At(v float64) (color.Color, error) {
+	switch {
+	case math.IsNaN(v):
+		return nil, ErrNaN
+	case v < m.min:
+		return nil, ErrUnderflow
+	case v > m.max:
+		return nil, ErrOverflow
+	}
+	return color.Gray{Y: uint8(255 * (v - m.min) / (m.max - m.min))}, nil
+}
+
+func (m *grayMap) Max() float64        { return m.max }
+func (m *grayMap) SetMax(v float64)    { m.max = v }
+func (m *grayMap) Min() float64        { return m.min }
+func (m *grayMap) SetMin(v float64)    { m.min = v }
+func (m *grayMap) Alpha() float64      { return 1 }
+func (m *grayMap) SetAlpha(float64)    {}
+func (m *grayMap) Palette(int) Palette { return nil }
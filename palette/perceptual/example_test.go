@@ -0,0 +1,128 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package perceptual_test
+
+import (
+	"log"
+	"math"
+	"os"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/palette/perceptual"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
+)
+
+type offsetUnitGrid struct {
+	XOffset, YOffset float64
+
+	Data *mat.Dense
+}
+
+func (g offsetUnitGrid) Dims() (c, r int)   { r, c = g.Data.Dims(); return c, r }
+func (g offsetUnitGrid) Z(c, r int) float64 { return g.Data.At(r, c) }
+func (g offsetUnitGrid) X(c int) float64 {
+	_, n := g.Data.Dims()
+	if c < 0 || n <= c {
+		panic("index out of range")
+	}
+	return float64(c) + g.XOffset
+}
+func (g offsetUnitGrid) Y(r int) float64 {
+	m, _ := g.Data.Dims()
+	if r < 0 || m <= r {
+		panic("index out of range")
+	}
+	return float64(r) + g.YOffset
+}
+
+// This Example gives examples of plots using the color maps in this package.
+// The sequential color maps show a scalar field, and the cyclic Twilight
+// color map shows the phase of the field.
+func Example() {
+	var (
+		field = offsetUnitGrid{
+			XOffset: -50,
+			YOffset: -50,
+			Data:    mat.NewDense(100, 100, nil),
+		}
+		phase = offsetUnitGrid{
+			XOffset: -50,
+			YOffset: -50,
+			Data:    mat.NewDense(100, 100, nil),
+		}
+	)
+	for i := range 100 {
+		for j := range 100 {
+			x := float64(i-50) / 10
+			y := float64(j-50) / 10
+			field.Data.Set(i, j, math.Sin(x*x+y*y)/(x*x+y*y))
+			phase.Data.Set(i, j, math.Atan2(y, x))
+		}
+	}
+
+	const (
+		rows = 3
+		cols = 3
+	)
+	c := vgimg.New(vg.Points(800), vg.Points(800))
+	dc := draw.New(c)
+	tiles := draw.Tiles{
+		Rows: rows,
+		Cols: cols,
+	}
+
+	twilight := perceptual.Twilight()
+	twilight.SetMin(-math.Pi)
+	twilight.SetMax(+math.Pi)
+
+	palettes := []struct {
+		name string
+		grid plotter.GridXYZ
+		cmap palette.Palette
+	}{
+		{name: "Viridis", grid: field, cmap: perceptual.Viridis().Palette(255)},
+		{name: "Magma", grid: field, cmap: perceptual.Magma().Palette(255)},
+		{name: "Inferno", grid: field, cmap: perceptual.Inferno().Palette(255)},
+		{name: "Plasma", grid: field, cmap: perceptual.Plasma().Palette(255)},
+		{name: "Cividis", grid: field, cmap: perceptual.Cividis().Palette(255)},
+		{name: "Turbo", grid: field, cmap: perceptual.Turbo().Palette(255)},
+		{name: "Twilight", grid: phase, cmap: twilight.Palette(255)},
+	}
+
+	for i, plte := range palettes {
+		h := plotter.NewHeatMap(plte.grid, plte.cmap)
+
+		p := plot.New()
+		p.Title.Text = plte.name
+
+		p.Add(h)
+
+		p.X.Padding = 0
+		p.Y.Padding = 0
+		p.Draw(tiles.At(dc, i%cols, i/cols))
+	}
+
+	pngimg := vgimg.PngCanvas{Canvas: c}
+	f, err := os.Create("testdata/perceptual.png")
+	if err != nil {
+		log.Panic(err)
+	}
+	defer f.Close()
+
+	if _, err = pngimg.WriteTo(f); err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestHeatMap(t *testing.T) {
+	cmpimg.CheckPlot(Example, t, "perceptual.png")
+}
@@ -0,0 +1,145 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package perceptual
+
+import "gonum.org/v1/plot/palette"
+
+// Viridis is a sequential ColorMap going from dark blue through
+// green to yellow, with a monotonically increasing lightness.
+// It was designed by Stéfan van der Walt and Nathaniel Smith
+// for matplotlib, and is readable by viewers with the most common
+// forms of color vision deficiency and when printed in gray scale.
+//
+// The colors are computed with the polynomial approximation of the
+// matplotlib color map by Matt Zucconi, corrected to go through the
+// colors sampled from the color map at ten evenly spaced values.
+func Viridis() palette.ColorMap {
+	return newColorMap(polynomial([][3]float64{
+		{0.2777273272234177, 0.005407344544966578, 0.3340998053353061},
+		{0.1050930431085774, 1.404613529898575, 1.384590162594685},
+		{-0.3308618287255563, 0.214847559468213, 0.09509516302823659},
+		{-4.634230498983486, -5.799100973351585, -19.33244095627987},
+		{6.228269936347081, 14.17993336680509, 56.69055260068105},
+		{4.776384997670288, -13.74514537774601, -65.35303263337234},
+		{-5.435455855934631, 4.645852612178535, 26.3124352495832},
+	}, []uint32{
+		0x440154, 0x482878, 0x3e4a89, 0x31688e, 0x26828e,
+		0x1f9e89, 0x35b779, 0x6dcd59, 0xb4de2c, 0xfde725,
+	}))
+}
+
+// Magma is a sequential ColorMap going from black through purple
+// and orange to light yellow, with a monotonically increasing
+// lightness. It was designed for matplotlib along with Viridis.
+//
+// The colors are computed with the polynomial approximation of the
+// matplotlib color map by Matt Zucconi, corrected to go through the
+// colors sampled from the color map at ten evenly spaced values.
+func Magma() palette.ColorMap {
+	return newColorMap(polynomial([][3]float64{
+		{-0.002136485053939582, -0.000749655052795221, -0.005386127855323933},
+		{0.2516605407371642, 0.6775232436837668, 2.494026599312351},
+		{8.353717279216625, -3.577719514958484, 0.3144679030132573},
+		{-27.66873308576866, 14.26473078096533, -13.64921318813922},
+		{52.17613981234068, -27.94360607168351, 12.94416944238394},
+		{-50.76852536473588, 29.04658282127291, 4.23415299384598},
+		{18.65570506591883, -11.48977351997711, -5.601961508734096},
+	}, []uint32{
+		0x000004, 0x180f3e, 0x451077, 0x721f81, 0x9f2f7f,
+		0xcd4071, 0xf1605d, 0xfd9567, 0xfec98d, 0xfcfdbf,
+	}))
+}
+
+// Inferno is a sequential ColorMap going from black through purple
+// and orange to pale yellow, with a monotonically increasing
+// lightness. It was designed for matplotlib along with Viridis.
+//
+// The colors are computed with the polynomial approximation of the
+// matplotlib color map by Matt Zucconi, corrected to go through the
+// colors sampled from the color map at ten evenly spaced values.
+func Inferno() palette.ColorMap {
+	return newColorMap(polynomial([][3]float64{
+		{0.0002189403691192265, 0.001651004631001012, -0.01948089843709184},
+		{0.1065134194856116, 0.5639564367884091, 3.932712388889277},
+		{11.60249308247187, -3.972853965665698, -15.9423941062914},
+		{-41.70399613139459, 17.43639888205313, 44.35414519872813},
+		{77.162935699427, -33.40235894210092, -81.80730925738993},
+		{-71.31942824499214, 32.62606426397723, 73.20951985803202},
+		{25.13112622477341, -12.24266895238567, -23.07032500287172},
+	}, []uint32{
+		0x000004, 0x1b0c42, 0x4b0c6b, 0x781c6d, 0xa52c60,
+		0xcf4446, 0xed6925, 0xfb9a06, 0xf7d03c, 0xfcffa4,
+	}))
+}
+
+// Plasma is a sequential ColorMap going from blue through purple
+// and orange to yellow, with a monotonically increasing lightness.
+// It was designed for matplotlib along with Viridis.
+//
+// The colors are computed with the polynomial approximation of the
+// matplotlib color map by Matt Zucconi, corrected to go through the
+// colors sampled from the color map at ten evenly spaced values.
+func Plasma() palette.ColorMap {
+	return newColorMap(polynomial([][3]float64{
+		{0.05873234392399702, 0.02333670892565664, 0.5433401826748754},
+		{2.176514634195958, 0.2383834171260182, 0.7539604599784036},
+		{-2.689460476458034, -7.455851135738909, 3.110799939717086},
+		{6.130348345893603, 42.3461881477227, -28.51885465332158},
+		{-11.10743619062271, -82.66631109428045, 60.13984767418263},
+		{10.02306557647065, 71.41361770095349, -54.07218655560067},
+		{-3.658713842777788, -22.93153465461149, 18.19190778539828},
+	}, []uint32{
+		0x0d0887, 0x47039f, 0x7301a8, 0x9c179e, 0xbd3786,
+		0xd8576b, 0xed7953, 0xfa9e3b, 0xfdc926, 0xf0f921,
+	}))
+}
+
+// Cividis is a sequential ColorMap going from dark blue through
+// gray to yellow, optimized by Nuñez, Anderton and Renslow to look
+// the same to viewers with and without color vision deficiency.
+// See "Optimizing colormaps with consideration for color vision
+// deficiency to enable accurate interpretation of scientific data",
+// PLoS ONE 13(7), 2018. DOI 10.1371/journal.pone.0199239.
+//
+// The colors are interpolated between colors sampled from the
+// published color map.
+func Cividis() palette.ColorMap {
+	return newColorMap(spline(rgbs([]uint32{
+		0x00204d, 0x00336f, 0x39486b, 0x575c6d, 0x707173,
+		0x8a8779, 0xa69d75, 0xc4b56c, 0xe4cf5b, 0xffea46,
+	}), false))
+}
+
+// Turbo is a sequential ColorMap going from dark blue through
+// cyan, green, yellow and orange to dark red. It was designed by
+// Anton Mikhailov as an improved rainbow color map, with a smooth
+// lightness profile and without the artificial bands of jet.
+// Unlike the other color maps of this package, its lightness is
+// not monotonic, and it should not be used where it matters.
+//
+// The colors are interpolated between colors sampled from the
+// published color map, and differ from those of the published
+// color map by up to 3% in each channel.
+func Turbo() palette.ColorMap {
+	return newColorMap(spline(rgbs([]uint32{
+		0x30123b, 0x4662d7, 0x36aaf9, 0x1ae4b6, 0x72fe5e,
+		0xc7ef34, 0xfaba39, 0xf66b19, 0xcb2a04, 0x7a0403,
+	}), false))
+}
+
+// Twilight is a cyclic ColorMap for phase data, going from light gray
+// through blue to dark purple and back through red to light gray,
+// so that the colors of its minimum and maximum values are the same.
+// It was designed by Bastian Bechtold for matplotlib, with a lightness
+// symmetric around its middle value.
+//
+// The colors are interpolated between colors sampled from the
+// matplotlib color map.
+func Twilight() palette.ColorMap {
+	return newColorMap(spline(rgbs([]uint32{
+		0xe2d9e2, 0xa7bcd1, 0x6181b9, 0x5d44a3, 0x2f1436,
+		0x862e52, 0xb35b48, 0xd0a08c, 0xe2d9e2,
+	}), true))
+}
@@ -0,0 +1,231 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package perceptual provides perceptually uniform color maps:
+// the sequential viridis, magma, inferno, plasma and cividis maps
+// designed for matplotlib, the turbo rainbow map and the cyclic
+// twilight map for phase data.
+//
+// The color maps follow the palette.ColorMap semantics of the
+// moreland package: values must be within [Min, Max], which are
+// both zero by default, and colors are opaque unless SetAlpha is
+// called.
+//
+// The color maps are approximations built from a few colors of the
+// published color maps, not their 256-entry lookup tables, and their
+// colors may differ from those of the lookup tables by a few units
+// in each eight-bit channel, and more for Turbo.
+package perceptual // import "gonum.org/v1/plot/palette/perceptual"
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot/palette"
+)
+
+// cmap is a color map whose colors are computed by the function
+// rgb for scalars between zero and one.
+type cmap struct {
+	// rgb returns the red, green and blue components of the
+	// sRGB color of the scalar t in [0,1], each in [0,1].
+	rgb func(t float64) (r, g, b float64)
+
+	// alpha represents the opacity of the returned
+	// colors in the range (0,1). It is 1 by default.
+	alpha float64
+
+	// min and max are the minimum and maximum values of the range of
+	// scalars that can be mapped to colors using this ColorMap.
+	min, max float64
+}
+
+func newColorMap(rgb func(t float64) (r, g, b float64)) palette.ColorMap {
+	return &cmap{rgb: rgb, alpha: 1}
+}
+
+// At implements the palette.ColorMap interface.
+func (c *cmap) At(v float64) (color.Color, error) {
+	if err := checkRange(c.min, c.max, v); err != nil {
+		return nil, err
+	}
+	r, g, b := c.rgb((v - c.min) / (c.max - c.min))
+	return color.NRGBA{
+		R: unit8(r),
+		G: unit8(g),
+		B: unit8(b),
+		A: unit8(c.alpha),
+	}, nil
+}
+
+// unit8 returns the value v in [0,1] scaled to [0,255],
+// clamping values out of range.
+func unit8(v float64) uint8 {
+	return uint8(math.Round(255 * math.Max(0, math.Min(1, v))))
+}
+
+func checkRange(min, max, val float64) error {
+	if max == min {
+		return fmt.Errorf("perceptual: color map max == min == %g", max)
+	}
+	if min > max {
+		return fmt.Errorf("perceptual: color map max (%g) < min (%g)", max, min)
+	}
+	if val < min {
+		return palette.ErrUnderflow
+	}
+	if val > max {
+		return palette.ErrOverflow
+	}
+	if math.IsNaN(val) {
+		return palette.ErrNaN
+	}
+	return nil
+}
+
+// SetMax implements the palette.ColorMap interface.
+func (c *cmap) SetMax(v float64) {
+	c.max = v
+}
+
+// SetMin implements the palette.ColorMap interface.
+func (c *cmap) SetMin(v float64) {
+	c.min = v
+}
+
+// Max implements the palette.ColorMap interface.
+func (c *cmap) Max() float64 {
+	return c.max
+}
+
+// Min implements the palette.ColorMap interface.
+func (c *cmap) Min() float64 {
+	return c.min
+}
+
+// SetAlpha sets the opacity value of this color map. Zero is transparent
+// and one is completely opaque.
+// The function will panic is alpha is not between zero and one.
+func (c *cmap) SetAlpha(alpha float64) {
+	if alpha < 0 || alpha > 1 {
+		panic(fmt.Errorf("perceptual: invalid alpha: %g", alpha))
+	}
+	c.alpha = alpha
+}
+
+// Alpha returns the opacity value of this color map.
+func (c *cmap) Alpha() float64 {
+	return c.alpha
+}
+
+// Palette returns a value that fulfills the palette.Palette interface,
+// where n is the number of desired colors.
+func (c cmap) Palette(n int) palette.Palette {
+	if c.Max() == 0 && c.Min() == 0 {
+		c.SetMin(0)
+		c.SetMax(1)
+	}
+	delta := (c.max - c.min) / float64(n-1)
+	var v float64
+	colors := make([]color.Color, n)
+	for i := range n {
+		if i == n-1 {
+			// Avoid potential overflow on last element
+			// due to floating point error.
+			v = c.max
+		} else {
+			v = c.min + delta*float64(i)
+		}
+		var err error
+		colors[i], err = c.At(v)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return plte(colors)
+}
+
+// plte fulfils the palette.Palette interface.
+type plte []color.Color
+
+// Colors fulfils the palette.Palette interface.
+func (p plte) Colors() []color.Color {
+	return p
+}
+
+// polynomial returns the sRGB color function of the polynomial
+// approximation of a color map, where c holds the coefficients
+// of increasing degree for the red, green and blue components.
+// The approximation is corrected to go through the evenly spaced
+// reference colors of the color map, given as 0xRRGGBB values.
+func polynomial(c [][3]float64, refs []uint32) func(t float64) (r, g, b float64) {
+	poly := func(t float64) (r, g, b float64) {
+		for i := len(c) - 1; i >= 0; i-- {
+			r = r*t + c[i][0]
+			g = g*t + c[i][1]
+			b = b*t + c[i][2]
+		}
+		return r, g, b
+	}
+	res := rgbs(refs)
+	for i := range res {
+		r, g, b := poly(float64(i) / float64(len(res)-1))
+		res[i][0] -= r
+		res[i][1] -= g
+		res[i][2] -= b
+	}
+	corr := spline(res, false)
+	return func(t float64) (r, g, b float64) {
+		r, g, b = poly(t)
+		dr, dg, db := corr(t)
+		return r + dr, g + dg, b + db
+	}
+}
+
+// spline returns the sRGB color function interpolating the evenly
+// spaced control colors with a Catmull-Rom spline. The control colors
+// of a cyclic color map wrap around, the last one being followed by
+// the second one.
+func spline(controls [][3]float64, cyclic bool) func(t float64) (r, g, b float64) {
+	n := len(controls)
+	at := func(i, j int) float64 {
+		switch {
+		case i < 0 && cyclic:
+			i += n - 1
+		case i >= n && cyclic:
+			i -= n - 1
+		case i < 0:
+			return 2*controls[0][j] - controls[1][j]
+		case i >= n:
+			return 2*controls[n-1][j] - controls[n-2][j]
+		}
+		return controls[i][j]
+	}
+	return func(t float64) (r, g, b float64) {
+		t *= float64(n - 1)
+		i := min(int(t), n-2)
+		f := t - float64(i)
+		comp := func(j int) float64 {
+			p0, p1 := at(i-1, j), at(i, j)
+			p2, p3 := at(i+1, j), at(i+2, j)
+			return p1 + 0.5*f*(p2-p0+f*(2*p0-5*p1+4*p2-p3+f*(3*(p1-p2)+p3-p0)))
+		}
+		return comp(0), comp(1), comp(2)
+	}
+}
+
+// rgbs returns the red, green and blue components
+// of the 0xRRGGBB colors, each in [0,1].
+func rgbs(colors []uint32) [][3]float64 {
+	o := make([][3]float64, len(colors))
+	for i, c := range colors {
+		o[i] = [3]float64{
+			float64(c>>16&0xff) / 255,
+			float64(c>>8&0xff) / 255,
+			float64(c&0xff) / 255,
+		}
+	}
+	return o
+}
@@ -0,0 +1,156 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package perceptual
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot/palette"
+)
+
+func TestColorMapReference(t *testing.T) {
+	// Reference colors at 0.25, 0.5 and 0.75, as given by the
+	// lookup tables of the matplotlib color maps, rounded to
+	// eight bits. Apart from those of Twilight, they lie between
+	// the colors the color maps are built from.
+	for _, test := range []struct {
+		name string
+		cmap palette.ColorMap
+		want [3]uint32
+		tol  int
+	}{
+		{name: "Viridis", cmap: Viridis(), want: [3]uint32{0x3b528b, 0x21918c, 0x5ec962}},
+		{name: "Magma", cmap: Magma(), want: [3]uint32{0x51127c, 0xb73779, 0xfc8961}},
+		{name: "Inferno", cmap: Inferno(), want: [3]uint32{0x57106e, 0xbc3754, 0xf98e09}},
+		{name: "Plasma", cmap: Plasma(), want: [3]uint32{0x7e03a8, 0xcc4778, 0xf89540}},
+		{name: "Cividis", cmap: Cividis(), want: [3]uint32{0x414d6b, 0x7c7b78, 0xbcaf6f}},
+		// The hue of Turbo changes faster than its
+		// interpolation between ten colors can follow.
+		{name: "Turbo", cmap: Turbo(), want: [3]uint32{0x28bceb, 0xa4fc3c, 0xfb7e21}, tol: 7},
+		{name: "Twilight", cmap: Twilight(), want: [3]uint32{0x6181b9, 0x2f1436, 0xb35b48}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			tol := test.tol
+			if tol == 0 {
+				tol = 3
+			}
+			test.cmap.SetMax(1)
+			for i, v := range []float64{0.25, 0.5, 0.75} {
+				c, err := test.cmap.At(v)
+				if err != nil {
+					t.Fatalf("unexpected error at %v: %v", v, err)
+				}
+				w := test.want[i]
+				want := color.NRGBA{R: uint8(w >> 16), G: uint8(w >> 8), B: uint8(w), A: 255}
+				if got := c.(color.NRGBA); !near(got, want, tol) {
+					t.Errorf("unexpected color at %v: got=%v, want=%v", v, got, want)
+				}
+			}
+		})
+	}
+}
+
+func near(a, b color.NRGBA, tol int) bool {
+	diff := func(a, b uint8) bool {
+		d := int(a) - int(b)
+		return -tol <= d && d <= tol
+	}
+	return diff(a.R, b.R) && diff(a.G, b.G) && diff(a.B, b.B) && diff(a.A, b.A)
+}
+
+func TestTwilightCyclic(t *testing.T) {
+	cmap := Twilight()
+	cmap.SetMin(-math.Pi)
+	cmap.SetMax(math.Pi)
+
+	lo, err := cmap.At(-math.Pi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	hi, err := cmap.At(math.Pi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lo != hi {
+		t.Errorf("colors at min and max differ: %v != %v", lo, hi)
+	}
+
+	// The colors must be continuous across the wrap around.
+	const eps = 1e-3
+	before, _ := cmap.At(math.Pi - eps)
+	after, _ := cmap.At(-math.Pi + eps)
+	if !near(before.(color.NRGBA), after.(color.NRGBA), 1) {
+		t.Errorf("colors around the wrap around differ: %v != %v", before, after)
+	}
+
+	mid, err := cmap.At(0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := mid.(color.NRGBA), (color.NRGBA{R: 0x2f, G: 0x14, B: 0x36, A: 0xff}); got != want {
+		t.Errorf("unexpected middle color: got=%v, want=%v", got, want)
+	}
+}
+
+func TestColorMapRange(t *testing.T) {
+	cmap := Viridis()
+
+	_, err := cmap.At(0)
+	if err == nil {
+		t.Errorf("expected an error for an empty range")
+	}
+
+	cmap.SetMin(2)
+	cmap.SetMax(4)
+	for _, test := range []struct {
+		v    float64
+		want error
+	}{
+		{v: 1, want: palette.ErrUnderflow},
+		{v: 5, want: palette.ErrOverflow},
+		{v: math.NaN(), want: palette.ErrNaN},
+	} {
+		_, err := cmap.At(test.v)
+		if !errors.Is(err, test.want) {
+			t.Errorf("unexpected error for %v: got=%v, want=%v", test.v, err, test.want)
+		}
+	}
+
+	cmap.SetAlpha(0.5)
+	c, err := cmap.At(4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := c.(color.NRGBA).A, uint8(128); got != want {
+		t.Errorf("unexpected alpha: got=%d, want=%d", got, want)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("expected a panic for an invalid alpha")
+			}
+		}()
+		cmap.SetAlpha(2)
+	}()
+}
+
+func TestPaletteDefaultRange(t *testing.T) {
+	for _, cmap := range []palette.ColorMap{
+		Viridis(), Magma(), Inferno(), Plasma(),
+		Cividis(), Turbo(), Twilight(),
+	} {
+		colors := cmap.Palette(256).Colors()
+		if len(colors) != 256 {
+			t.Errorf("unexpected number of colors: got=%d, want=256", len(colors))
+		}
+		if cmap.Min() != 0 || cmap.Max() != 0 {
+			t.Errorf("palette modified the range of the color map: [%g, %g]", cmap.Min(), cmap.Max())
+		}
+	}
+}
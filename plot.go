@@ -45,6 +45,10 @@ type Plot struct {
 
 		// TextStyle specifies how the plot title text should be displayed.
 		TextStyle text.Style
+
+		// Wrap specifies how the lines of the plot
+		// title are laid out within a maximum width.
+		Wrap text.Wrap
 	}
 
 	// BackgroundColor is the background color of the plot.
@@ -58,6 +62,14 @@ type Plot struct {
 	// Legend is the plot's legend.
 	Legend Legend
 
+	// ColorBar, if not nil, is the color bar drawn
+	// alongside the data area of the plot.
+	ColorBar *ColorBar
+
+	// Aspect constrains the aspect ratio
+	// of the data area of the plot.
+	Aspect Aspect
+
 	// TextHandler parses and formats text according to a given
 	// dialect (Markdown, LaTeX, plain, ...)
 	// The default is a plain text handler.
@@ -66,6 +78,9 @@ type Plot struct {
 	// plotters are drawn by calling their Plot method
 	// after the axes are drawn.
 	plotters []Plotter
+
+	// insets are drawn after the plotters.
+	insets []*Inset
 }
 
 // Plotter is an interface that wraps the Plot method.
@@ -147,49 +162,109 @@ func (p *Plot) Draw(c draw.Canvas) {
 		c.SetColor(p.BackgroundColor)
 		c.Fill(c.Rectangle.Path())
 	}
+	p, c = p.aspect(c)
 
 	if p.Title.Text != "" {
 		descent := p.Title.TextStyle.FontExtents().Descent
-		c.FillText(p.Title.TextStyle, vg.Point{X: c.Center().X, Y: c.Max.Y + descent}, p.Title.Text)
+		c.FillText(p.Title.TextStyle, vg.Point{X: c.Center().X, Y: c.Max.Y + descent}, p.title())
 
-		rect := p.Title.TextStyle.Rectangle(p.Title.Text)
+		rect := p.Title.TextStyle.Rectangle(p.title())
 		c.Max.Y -= rect.Size().Y
 		c.Max.Y -= p.Title.Padding
 	}
 
-	p.X.sanitizeRange()
-	x := horizontalAxis{p.X}
-	p.Y.sanitizeRange()
-	y := verticalAxis{p.Y}
+	var cb draw.Canvas
+	if p.ColorBar != nil {
+		c, cb = p.ColorBar.crop(c)
+	}
 
-	ywidth := y.size()
+	x, y := p.axes(c)
 
-	xheight := x.size()
-	x.draw(padX(p, draw.Crop(c, ywidth, 0, 0, 0)))
-	y.draw(padY(p, draw.Crop(c, 0, 0, xheight, 0)))
+	ywidth, right := y.size(), y.mirrorSize()
+	xheight, top := x.size(), x.mirrorSize()+y.annotationSize()
+
+	cx := padX(p, x, draw.Crop(c, ywidth, -right, 0, 0))
+	cy := padY(p, y, draw.Crop(c, 0, 0, xheight, -top))
+	dataC := padY(p, y, padX(p, x, draw.Crop(c, ywidth, -right, xheight, -top)))
+	if x.mirrorSize() > 0 {
+		x.drawMirror(draw.Crop(cx, 0, 0, cx.Max.Y-cx.Min.Y-top, 0))
+	}
+	if right > 0 {
+		y.drawMirror(draw.Crop(cy, cy.Max.X-cy.Min.X-right, 0, 0, 0))
+	}
+	if x.Position == AxisCross {
+		x.drawCross(cx, crossAt(dataC.Y(p.Y.Norm(x.Cross)), dataC.Min.Y, dataC.Max.Y))
+	} else {
+		x.draw(cx)
+	}
+	if y.Position == AxisCross {
+		y.drawCross(cy, crossAt(dataC.X(p.X.Norm(y.Cross)), dataC.Min.X, dataC.Max.X))
+	} else {
+		y.draw(cy)
+	}
 
-	dataC := padY(p, padX(p, draw.Crop(c, ywidth, 0, xheight, 0)))
 	for _, data := range p.plotters {
 		data.Plot(dataC, p)
 	}
+	for _, in := range p.insets {
+		in.draw(dataC, p)
+	}
+
+	p.Legend.Draw(draw.Crop(c, ywidth, -right, xheight, -top))
+
+	if p.ColorBar != nil {
+		p.ColorBar.draw(cb, dataC)
+	}
+}
 
-	p.Legend.Draw(draw.Crop(c, ywidth, 0, xheight, 0))
+// title returns the text of the plot title,
+// wrapped within its maximum width.
+func (p *Plot) title() string {
+	return p.Title.Wrap.Text(p.Title.TextStyle, p.Title.Text)
 }
 
 // DataCanvas returns a new draw.Canvas that
 // is the subset of the given draw area into which
 // the plot data will be drawn.
 func (p *Plot) DataCanvas(da draw.Canvas) draw.Canvas {
+	p, da = p.aspect(da)
+	return p.dataCanvas(da)
+}
+
+// dataCanvas returns the data canvas of the plot
+// drawn to da, ignoring the aspect constraint.
+func (p *Plot) dataCanvas(da draw.Canvas) draw.Canvas {
 	if p.Title.Text != "" {
-		rect := p.Title.TextStyle.Rectangle(p.Title.Text)
+		rect := p.Title.TextStyle.Rectangle(p.title())
 		da.Max.Y -= rect.Size().Y
 		da.Max.Y -= p.Title.Padding
 	}
+	if p.ColorBar != nil {
+		da, _ = p.ColorBar.crop(da)
+	}
+	x, y := p.axes(da)
+	return padY(p, y, padX(p, x, draw.Crop(da, y.size(), -y.mirrorSize(), x.size(), -x.mirrorSize()-y.annotationSize())))
+}
+
+// axes returns the X and Y axes of the plot drawn to c,
+// with their tick labels laid out to avoid overlaps.
+func (p *Plot) axes(c draw.Canvas) (horizontalAxis, verticalAxis) {
 	p.X.sanitizeRange()
-	x := horizontalAxis{p.X}
+	x := horizontalAxis{p.X.wrapLabel()}
 	p.Y.sanitizeRange()
-	y := verticalAxis{p.Y}
-	return padY(p, padX(p, draw.Crop(da, y.size(), 0, x.size(), 0)))
+	y := verticalAxis{p.Y.wrapLabel()}
+
+	width := c.Max.X - c.Min.X - y.size() - y.mirrorSize()
+	x.Axis = x.avoidOverlap(width, horizontal)
+	height := c.Max.Y - c.Min.Y - x.size() - x.mirrorSize() - y.annotationSize()
+	y.Axis = y.avoidOverlap(height, vertical)
+	return x, y
+}
+
+// crossAt returns the position v of an axis crossing
+// the other axis, clamped to the range [min, max].
+func crossAt(v, min, max vg.Length) vg.Length {
+	return vg.Length(math.Max(float64(min), math.Min(float64(v), float64(max))))
 }
 
 // DrawGlyphBoxes draws red outlines around the plot's
@@ -215,7 +290,7 @@ func (p *Plot) DrawGlyphBoxes(c draw.Canvas) {
 
 	var title vg.Length
 	if p.Title.Text != "" {
-		rect := p.Title.TextStyle.Rectangle(p.Title.Text)
+		rect := p.Title.TextStyle.Rectangle(p.title())
 		title += rect.Size().Y
 		title += p.Title.Padding
 		box := GlyphBox{
@@ -231,21 +306,17 @@ func (p *Plot) DrawGlyphBoxes(c draw.Canvas) {
 		drawBox(dac, b)
 	}
 
-	p.X.sanitizeRange()
-	p.Y.sanitizeRange()
-
-	x := horizontalAxis{p.X}
-	y := verticalAxis{p.Y}
+	x, y := p.axes(c)
 
 	ywidth := y.size()
 	xheight := x.size()
 
-	cx := padX(p, draw.Crop(c, ywidth, 0, 0, 0))
+	cx := padX(p, x, draw.Crop(c, ywidth, -y.mirrorSize(), 0, 0))
 	for _, b := range x.GlyphBoxes(p) {
 		drawBox(cx, b)
 	}
 
-	cy := padY(p, draw.Crop(c, 0, 0, xheight, 0))
+	cy := padY(p, y, draw.Crop(c, 0, 0, xheight, -x.mirrorSize()-y.annotationSize()))
 	cy.Max.Y -= title
 	for _, b := range y.GlyphBoxes(p) {
 		drawBox(cy, b)
@@ -254,10 +325,9 @@ func (p *Plot) DrawGlyphBoxes(c draw.Canvas) {
 
 // padX returns a draw.Canvas that is padded horizontally
 // so that glyphs will no be clipped.
-func padX(p *Plot, c draw.Canvas) draw.Canvas {
+func padX(p *Plot, xAxis horizontalAxis, c draw.Canvas) draw.Canvas {
 	glyphs := p.GlyphBoxes(p)
 	l := leftMost(&c, glyphs)
-	xAxis := horizontalAxis{p.X}
 	glyphs = append(glyphs, xAxis.GlyphBoxes(p)...)
 	r := rightMost(&c, glyphs)
 
@@ -310,10 +380,9 @@ func leftMost(c *draw.Canvas, boxes []GlyphBox) GlyphBox {
 
 // padY returns a draw.Canvas that is padded vertically
 // so that glyphs will no be clipped.
-func padY(p *Plot, c draw.Canvas) draw.Canvas {
+func padY(p *Plot, yAxis verticalAxis, c draw.Canvas) draw.Canvas {
 	glyphs := p.GlyphBoxes(p)
 	b := bottomMost(&c, glyphs)
-	yAxis := verticalAxis{p.Y}
 	glyphs = append(glyphs, yAxis.GlyphBoxes(p)...)
 	t := topMost(&c, glyphs)
 
@@ -476,6 +545,18 @@ func (p *Plot) HideAxes() {
 	p.HideY()
 }
 
+// Frame draws a box frame around the data area of the plot,
+// mirroring the lines and the tick marks of the X and Y axes,
+// with their current styles, along the top and right edges of
+// the data area. The padding of the axes is removed so that
+// the lines meet at the corners.
+func (p *Plot) Frame() {
+	p.X.Padding = 0
+	p.Y.Padding = 0
+	p.X.Mirror = p.X.Side(true, true, false)
+	p.Y.Mirror = p.Y.Side(true, true, false)
+}
+
 // NominalY is like NominalX, but for the Y axis.
 func (p *Plot) NominalY(names ...string) {
 	p.Y.Tick.Width = 0
@@ -522,7 +603,15 @@ func (p *Plot) WriterTo(w, h vg.Length, format string) (io.WriterTo, error) {
 //   - .svg
 //   - .tex
 //   - .tif|.tiff
-func (p *Plot) Save(w, h vg.Length, file string) (err error) {
+func (p *Plot) Save(w, h vg.Length, file string) error {
+	return save(file, func(format string) (io.WriterTo, error) {
+		return p.WriterTo(w, h, format)
+	})
+}
+
+// save saves to an image file the io.WriterTo returned by
+// writerTo for the format determined by the file extension.
+func save(file string, writerTo func(format string) (io.WriterTo, error)) (err error) {
 	f, err := os.Create(file)
 	if err != nil {
 		return err
@@ -538,7 +627,7 @@ func (p *Plot) Save(w, h vg.Length, file string) (err error) {
 	if len(format) != 0 {
 		format = format[1:]
 	}
-	c, err := p.WriterTo(w, h, format)
+	c, err := writerTo(format)
 	if err != nil {
 		return err
 	}
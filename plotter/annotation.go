@@ -0,0 +1,518 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/text"
+	"gonum.org/v1/plot/tools/bezier"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// AnnotationCoordinates specifies the coordinate
+// system of a location of an Annotation.
+type AnnotationCoordinates int
+
+const (
+	// AnnotationData locates points in the
+	// data coordinates of the plot.
+	AnnotationData AnnotationCoordinates = iota
+
+	// AnnotationAxes locates points in the normalized
+	// coordinates of the data area of the plot, where
+	// (0, 0) is the lower left corner of the data area
+	// and (1, 1) its upper right corner.
+	AnnotationAxes
+
+	// AnnotationCanvas locates points by their distance,
+	// in points, from the lower left corner of the data
+	// area of the plot.
+	AnnotationCanvas
+)
+
+// AnnotationConnector specifies the shape of the
+// arrow of an Annotation.
+type AnnotationConnector int
+
+const (
+	// AnnotationStraight connects the text and its
+	// target with a straight line.
+	AnnotationStraight AnnotationConnector = iota
+
+	// AnnotationArc connects the text and its target
+	// with an arc of circle.
+	AnnotationArc
+
+	// AnnotationBezier connects the text and its target
+	// with an S-shaped cubic Bézier curve.
+	AnnotationBezier
+)
+
+// AnnotationArrowHead specifies the shape of the
+// head of the arrow of an Annotation.
+type AnnotationArrowHead int
+
+const (
+	// AnnotationFilledHead is a filled triangular head.
+	AnnotationFilledHead AnnotationArrowHead = iota
+
+	// AnnotationOpenHead is a head made of two strokes.
+	AnnotationOpenHead
+
+	// AnnotationNoHead draws no head.
+	AnnotationNoHead
+)
+
+// AnnotationBox describes the box drawn around the text of an Annotation.
+type AnnotationBox struct {
+	// FillColor is the color used to fill the box.
+	// If FillColor is nil, the box is not filled.
+	FillColor color.Color
+
+	// LineStyle is the style of the border of the box.
+	// Use zero width to disable the border.
+	draw.LineStyle
+
+	// Padding is the distance between the text
+	// and the border of the box.
+	Padding vg.Length
+
+	// Radius is the radius of the rounded
+	// corners of the box.
+	Radius vg.Length
+}
+
+// AnnotationArrow describes the arrow drawn from the
+// text of an Annotation to its target.
+type AnnotationArrow struct {
+	// Target is the location pointed to by the arrow.
+	Target XY
+
+	// Coordinates is the coordinate system of Target.
+	Coordinates AnnotationCoordinates
+
+	// Connector is the shape of the arrow.
+	Connector AnnotationConnector
+
+	// Bend is the curvature of AnnotationArc and
+	// AnnotationBezier arrows, as a fraction of the
+	// distance between the text and the target.
+	// The sign of Bend selects the side of the curve.
+	// The curve is bent in the normalized coordinates
+	// of the data area, unless the text or the target
+	// is located in AnnotationCanvas coordinates.
+	Bend float64
+
+	// LineStyle is the style of the arrow.
+	draw.LineStyle
+
+	// Head is the shape of the arrow head.
+	Head AnnotationArrowHead
+
+	// HeadSize is the length of the arrow head.
+	HeadSize vg.Length
+
+	// Shrink is the gap left between the
+	// head of the arrow and its target.
+	Shrink vg.Length
+}
+
+// Annotation implements the Plotter interface, drawing a
+// text, optionally within a box, with an optional arrow
+// pointing to a target location.
+//
+// Annotation does not implement the plot.DataRanger
+// interface, so it does not change the range of the axes.
+type Annotation struct {
+	// Text is the annotation text.
+	Text string
+
+	// TextStyle is the style of the text.
+	TextStyle text.Style
+
+	// Location is the location of the text.
+	Location XY
+
+	// Coordinates is the coordinate system of Location.
+	Coordinates AnnotationCoordinates
+
+	// Box is the box drawn around the text.
+	Box AnnotationBox
+
+	// Arrow, if not nil, is the arrow drawn
+	// from the text to its target.
+	Arrow *AnnotationArrow
+}
+
+// NewAnnotation returns an Annotation with the given text,
+// centered at (x, y) in the given coordinate system, using
+// the DefaultFont and the DefaultFontSize.
+func NewAnnotation(txt string, x, y float64, coords AnnotationCoordinates) *Annotation {
+	return &Annotation{
+		Text: txt,
+		TextStyle: text.Style{
+			Color:   color.Black,
+			Font:    font.From(DefaultFont, DefaultFontSize),
+			XAlign:  draw.XCenter,
+			YAlign:  draw.YCenter,
+			Handler: plot.DefaultTextHandler,
+		},
+		Location:    XY{X: x, Y: y},
+		Coordinates: coords,
+		Box: AnnotationBox{
+			Padding: vg.Points(3),
+			Radius:  vg.Points(3),
+		},
+	}
+}
+
+// SetArrow sets the arrow of the annotation to a straight
+// arrow with a filled head pointing to (x, y), in the given
+// coordinate system, and returns it.
+func (a *Annotation) SetArrow(x, y float64, coords AnnotationCoordinates) *AnnotationArrow {
+	a.Arrow = &AnnotationArrow{
+		Target:      XY{X: x, Y: y},
+		Coordinates: coords,
+		LineStyle:   DefaultLineStyle,
+		HeadSize:    vg.Points(6),
+		Shrink:      vg.Points(2),
+	}
+	return a.Arrow
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+func (a *Annotation) Plot(c draw.Canvas, plt *plot.Plot) {
+	c.Push()
+	defer c.Pop()
+
+	pt := location(c, plt, a.Location, a.Coordinates)
+	box := a.box().Add(pt)
+
+	if a.Arrow != nil {
+		pts := a.Arrow.canvasPath(c, plt, a.Location, a.Coordinates)
+		a.Arrow.draw(c, trimPath(pts, box))
+	}
+
+	if a.Box.FillColor != nil || a.Box.Width > 0 {
+		pa := roundedRectangle(box, a.Box.Radius)
+		if a.Box.FillColor != nil {
+			c.SetColor(a.Box.FillColor)
+			c.Fill(pa)
+		}
+		if a.Box.Width > 0 {
+			c.SetLineStyle(a.Box.LineStyle)
+			c.Stroke(pa)
+		}
+	}
+
+	if a.Text != "" {
+		c.FillText(a.TextStyle, pt, a.Text)
+	}
+}
+
+// box returns the rectangle of the text box, relative
+// to the location of the text.
+func (a *Annotation) box() vg.Rectangle {
+	r := a.TextStyle.Rectangle(a.Text)
+	r.Min.X -= a.Box.Padding
+	r.Min.Y -= a.Box.Padding
+	r.Max.X += a.Box.Padding
+	r.Max.Y += a.Box.Padding
+	return r
+}
+
+// GlyphBoxes implements the GlyphBoxes method
+// of the plot.GlyphBoxer interface.
+//
+// The glyph boxes cover the text box and, when the Annotation
+// has an Arrow, the path and the head of the arrow. The path of
+// a curved arrow between a location in AnnotationCanvas and a
+// location in another coordinate system is approximated by its
+// chord.
+func (a *Annotation) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	r := a.box()
+	w := a.Box.Width / 2
+	r.Min.X -= w
+	r.Min.Y -= w
+	r.Max.X += w
+	r.Max.Y += w
+
+	pos, off := glyphLocation(plt, a.Location, a.Coordinates)
+	boxes := []plot.GlyphBox{{X: pos.X, Y: pos.Y, Rectangle: r.Add(off)}}
+	if a.Arrow != nil {
+		boxes = append(boxes, a.Arrow.glyphBoxes(plt, a.Location, a.Coordinates)...)
+	}
+	return boxes
+}
+
+// glyphBoxes returns the glyph boxes of the arrow drawn from
+// the location from, in the coordinate system coords, to its
+// target. The path is computed in the same space as in
+// canvasPath.
+func (arr *AnnotationArrow) glyphBoxes(plt *plot.Plot, from XY, coords AnnotationCoordinates) []plot.GlyphBox {
+	pos0, off0 := glyphLocation(plt, from, coords)
+	pos1, off1 := glyphLocation(plt, arr.Target, arr.Coordinates)
+
+	w := arr.Width / 2
+	line := vg.Rectangle{Min: vg.Point{X: -w, Y: -w}, Max: vg.Point{X: w, Y: w}}
+	var boxes []plot.GlyphBox
+	switch {
+	case coords != AnnotationCanvas && arr.Coordinates != AnnotationCanvas:
+		// The path is computed in normalized coordinates.
+		pts := arr.path(vg.Point{X: vg.Length(pos0.X), Y: vg.Length(pos0.Y)}, vg.Point{X: vg.Length(pos1.X), Y: vg.Length(pos1.Y)})
+		for _, pt := range pts {
+			boxes = append(boxes, plot.GlyphBox{X: float64(pt.X), Y: float64(pt.Y), Rectangle: line})
+		}
+	case coords == AnnotationCanvas && arr.Coordinates == AnnotationCanvas:
+		for _, pt := range arr.path(off0, off1) {
+			boxes = append(boxes, plot.GlyphBox{Rectangle: line.Add(pt)})
+		}
+	default:
+		boxes = append(boxes,
+			plot.GlyphBox{X: pos0.X, Y: pos0.Y, Rectangle: line.Add(off0)},
+			plot.GlyphBox{X: pos1.X, Y: pos1.Y, Rectangle: line.Add(off1)},
+		)
+	}
+
+	if arr.Head != AnnotationNoHead && arr.HeadSize > 0 {
+		// The head lies within HeadSize of its tip,
+		// which is Shrink away from the target.
+		h := arr.Shrink + arr.HeadSize + w
+		head := vg.Rectangle{Min: vg.Point{X: -h, Y: -h}, Max: vg.Point{X: h, Y: h}}
+		boxes = append(boxes, plot.GlyphBox{X: pos1.X, Y: pos1.Y, Rectangle: head.Add(off1)})
+	}
+	return boxes
+}
+
+// canvasPath returns the canvas points of the path of the arrow
+// drawn from the location from, in the coordinate system coords,
+// to its target. Unless one of its ends is in AnnotationCanvas
+// coordinates, the path is computed in the normalized coordinates
+// of the data area, as its glyph boxes, and then placed on c.
+func (arr *AnnotationArrow) canvasPath(c draw.Canvas, plt *plot.Plot, from XY, coords AnnotationCoordinates) []vg.Point {
+	if coords == AnnotationCanvas || arr.Coordinates == AnnotationCanvas {
+		return arr.path(location(c, plt, from, coords), location(c, plt, arr.Target, arr.Coordinates))
+	}
+	pos0, _ := glyphLocation(plt, from, coords)
+	pos1, _ := glyphLocation(plt, arr.Target, arr.Coordinates)
+	pts := arr.path(vg.Point{X: vg.Length(pos0.X), Y: vg.Length(pos0.Y)}, vg.Point{X: vg.Length(pos1.X), Y: vg.Length(pos1.Y)})
+	for i, pt := range pts {
+		pts[i] = vg.Point{X: c.X(float64(pt.X)), Y: c.Y(float64(pt.Y))}
+	}
+	return pts
+}
+
+// trimPath returns the part of the path pts after it leaves
+// the rectangle r, or nil if the path ends within r.
+func trimPath(pts []vg.Point, r vg.Rectangle) []vg.Point {
+	for i, pt := range pts {
+		if pt.X < r.Min.X || pt.X > r.Max.X || pt.Y < r.Min.Y || pt.Y > r.Max.Y {
+			if i == 0 {
+				return pts
+			}
+			pts[i-1] = clipToRectangle(r, pts[i-1], pt)
+			return pts[i-1:]
+		}
+	}
+	return nil
+}
+
+// draw draws the arrow along the path pts.
+func (arr *AnnotationArrow) draw(c draw.Canvas, pts []vg.Point) {
+	if len(pts) < 2 {
+		return
+	}
+	to := pts[len(pts)-1]
+
+	// Direction of the arrow at its tip.
+	last := pts[len(pts)-1].Sub(pts[len(pts)-2])
+	l := vg.Length(math.Hypot(float64(last.X), float64(last.Y)))
+	if l == 0 {
+		return
+	}
+	dir := last.Scale(1 / l)
+
+	tip := to.Sub(dir.Scale(arr.Shrink))
+	pts[len(pts)-1] = tip
+	if arr.Head == AnnotationFilledHead {
+		// Stop the line at the base of the head,
+		// so that it does not show through the tip.
+		pts[len(pts)-1] = tip.Sub(dir.Scale(arr.HeadSize / 2))
+	}
+	c.StrokeLines(arr.LineStyle, pts)
+	drawArrowHead(c, arr.LineStyle, arr.Head, tip, dir, arr.HeadSize)
+}
+
+// path returns the points of the path of the arrow
+// from the point from to the point to.
+func (arr *AnnotationArrow) path(from, to vg.Point) []vg.Point {
+	switch arr.Connector {
+	case AnnotationStraight:
+		return []vg.Point{from, to}
+	case AnnotationArc:
+		return arc(from, to, arr.Bend)
+	case AnnotationBezier:
+		d := to.Sub(from)
+		// Offset of the control points across the chord.
+		off := vg.Point{X: -d.Y, Y: d.X}.Scale(vg.Length(arr.Bend))
+		curve := bezier.New(
+			from,
+			from.Add(d.Scale(1.0/3)).Add(off),
+			from.Add(d.Scale(2.0/3)).Sub(off),
+			to,
+		)
+		return curve.Curve(make([]vg.Point, 50))
+	default:
+		panic("plotter: unknown annotation connector")
+	}
+}
+
+// arc returns points along an arc of circle from p to q whose
+// sagitta is bend times the distance between p and q.
+func arc(p, q vg.Point, bend float64) []vg.Point {
+	if bend == 0 {
+		return []vg.Point{p, q}
+	}
+	const n = 50
+	d := q.Sub(p)
+	dist := math.Hypot(float64(d.X), float64(d.Y))
+	s := bend * dist
+	// Radius and center of the circle through p and q
+	// with sagitta s.
+	r := (s*s + dist*dist/4) / (2 * s)
+	mid := p.Add(d.Scale(0.5))
+	nx, ny := -float64(d.Y)/dist, float64(d.X)/dist
+	cx := float64(mid.X) + nx*(s-r)
+	cy := float64(mid.Y) + ny*(s-r)
+
+	a0 := math.Atan2(float64(p.Y)-cy, float64(p.X)-cx)
+	a1 := math.Atan2(float64(q.Y)-cy, float64(q.X)-cx)
+	am := math.Atan2(float64(mid.Y)+ny*s-cy, float64(mid.X)+nx*s-cx)
+	mod := func(a float64) float64 {
+		return math.Mod(math.Mod(a, 2*math.Pi)+2*math.Pi, 2*math.Pi)
+	}
+	// Choose the sweep passing through the sagitta point.
+	sweep := mod(a1 - a0)
+	if mod(am-a0) > sweep {
+		sweep -= 2 * math.Pi
+	}
+	pts := make([]vg.Point, n+1)
+	for i := range pts {
+		sin, cos := math.Sincos(a0 + sweep*float64(i)/n)
+		pts[i] = vg.Point{
+			X: vg.Length(cx + math.Abs(r)*cos),
+			Y: vg.Length(cy + math.Abs(r)*sin),
+		}
+	}
+	pts[0], pts[n] = p, q
+	return pts
+}
+
+// drawArrowHead draws an arrow head of the given kind and length,
+// with its tip at tip and pointing in the unit direction dir.
+func drawArrowHead(c draw.Canvas, sty draw.LineStyle, kind AnnotationArrowHead, tip, dir vg.Point, size vg.Length) {
+	if size <= 0 {
+		return
+	}
+	n := vg.Point{X: -dir.Y, Y: dir.X}
+	base := tip.Sub(dir.Scale(size))
+	left := base.Add(n.Scale(size / 3))
+	right := base.Sub(n.Scale(size / 3))
+	switch kind {
+	case AnnotationFilledHead:
+		col := sty.Color
+		if col == nil {
+			col = color.Black
+		}
+		c.FillPolygon(col, []vg.Point{tip, left, right})
+	case AnnotationOpenHead:
+		sty.Dashes = nil
+		c.StrokeLines(sty, []vg.Point{left, tip, right})
+	case AnnotationNoHead:
+	default:
+		panic("plotter: unknown arrow head")
+	}
+}
+
+// location returns the canvas location of xy in the given
+// coordinate system.
+func location(c draw.Canvas, plt *plot.Plot, xy XY, coords AnnotationCoordinates) vg.Point {
+	switch coords {
+	case AnnotationData:
+		trX, trY := plt.Transforms(&c)
+		return vg.Point{X: trX(xy.X), Y: trY(xy.Y)}
+	case AnnotationAxes:
+		return vg.Point{X: c.X(xy.X), Y: c.Y(xy.Y)}
+	case AnnotationCanvas:
+		return c.Min.Add(vg.Point{X: vg.Length(xy.X), Y: vg.Length(xy.Y)})
+	default:
+		panic("plotter: unknown coordinates")
+	}
+}
+
+// glyphLocation returns the location of xy in the given coordinate
+// system as a position in the normalized coordinates of the data area,
+// and an offset from that position, as used by glyph boxes.
+func glyphLocation(plt *plot.Plot, xy XY, coords AnnotationCoordinates) (pos XY, off vg.Point) {
+	switch coords {
+	case AnnotationData:
+		return XY{X: plt.X.Norm(xy.X), Y: plt.Y.Norm(xy.Y)}, vg.Point{}
+	case AnnotationAxes:
+		return xy, vg.Point{}
+	case AnnotationCanvas:
+		return XY{}, vg.Point{X: vg.Length(xy.X), Y: vg.Length(xy.Y)}
+	default:
+		panic("plotter: unknown coordinates")
+	}
+}
+
+// clipToRectangle returns the point where the segment from
+// the point from inside r to the point to leaves r. If to is
+// inside r, from is returned.
+func clipToRectangle(r vg.Rectangle, from, to vg.Point) vg.Point {
+	d := to.Sub(from)
+	t := 1.0
+	if d.X > 0 {
+		t = math.Min(t, float64((r.Max.X-from.X)/d.X))
+	} else if d.X < 0 {
+		t = math.Min(t, float64((r.Min.X-from.X)/d.X))
+	}
+	if d.Y > 0 {
+		t = math.Min(t, float64((r.Max.Y-from.Y)/d.Y))
+	} else if d.Y < 0 {
+		t = math.Min(t, float64((r.Min.Y-from.Y)/d.Y))
+	}
+	if t >= 1 || t < 0 {
+		return from
+	}
+	return from.Add(d.Scale(vg.Length(t)))
+}
+
+// roundedRectangle returns the path of the rectangle r
+// with corners rounded with the given radius.
+func roundedRectangle(r vg.Rectangle, radius vg.Length) vg.Path {
+	size := r.Size()
+	radius = min(radius, size.X/2, size.Y/2)
+	if radius <= 0 {
+		return r.Path()
+	}
+	var pa vg.Path
+	pa.Move(vg.Point{X: r.Min.X + radius, Y: r.Min.Y})
+	pa.Line(vg.Point{X: r.Max.X - radius, Y: r.Min.Y})
+	pa.Arc(vg.Point{X: r.Max.X - radius, Y: r.Min.Y + radius}, radius, -math.Pi/2, math.Pi/2)
+	pa.Line(vg.Point{X: r.Max.X, Y: r.Max.Y - radius})
+	pa.Arc(vg.Point{X: r.Max.X - radius, Y: r.Max.Y - radius}, radius, 0, math.Pi/2)
+	pa.Line(vg.Point{X: r.Min.X + radius, Y: r.Max.Y})
+	pa.Arc(vg.Point{X: r.Min.X + radius, Y: r.Max.Y - radius}, radius, math.Pi/2, math.Pi/2)
+	pa.Line(vg.Point{X: r.Min.X, Y: r.Min.Y + radius})
+	pa.Arc(vg.Point{X: r.Min.X + radius, Y: r.Min.Y + radius}, radius, math.Pi, math.Pi/2)
+	pa.Close()
+	return pa
+}
@@ -0,0 +1,55 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExampleAnnotation draws annotations with arrows pointing
+// to features of a function.
+func ExampleAnnotation() {
+	p := plot.New()
+	p.Title.Text = "Annotations"
+	p.X.Min, p.X.Max = 0, 2*math.Pi
+	p.Y.Min, p.Y.Max = -1.5, 1.5
+
+	sin := plotter.NewFunction(math.Sin)
+	p.Add(sin)
+
+	maxi := plotter.NewAnnotation("maximum", 3, 1.2, plotter.AnnotationData)
+	maxi.Box.FillColor = color.RGBA{R: 255, G: 255, B: 200, A: 255}
+	maxi.Box.LineStyle = plotter.DefaultLineStyle
+	maxi.Box.Width = vg.Points(0.5)
+	maxi.SetArrow(math.Pi/2, 1, plotter.AnnotationData)
+
+	mini := plotter.NewAnnotation("minimum", 3, -1.2, plotter.AnnotationData)
+	arr := mini.SetArrow(3*math.Pi/2, -1, plotter.AnnotationData)
+	arr.Connector = plotter.AnnotationArc
+	arr.Bend = -0.3
+	arr.Head = plotter.AnnotationOpenHead
+
+	zero := plotter.NewAnnotation("zero", 0.15, 0.25, plotter.AnnotationAxes)
+	arr = zero.SetArrow(math.Pi, 0, plotter.AnnotationData)
+	arr.Connector = plotter.AnnotationBezier
+	arr.Bend = 0.3
+	arr.Color = color.RGBA{B: 255, A: 255}
+
+	corner := plotter.NewAnnotation("corner", 1, 1, plotter.AnnotationAxes)
+	corner.Box.LineStyle = plotter.DefaultLineStyle
+
+	p.Add(maxi, mini, zero, corner)
+
+	err := p.Save(250, 200, "testdata/annotation.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
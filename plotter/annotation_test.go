@@ -0,0 +1,149 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestAnnotation(t *testing.T) {
+	cmpimg.CheckPlot(ExampleAnnotation, t, "annotation.png")
+}
+
+func TestAnnotationGlyphBoxes(t *testing.T) {
+	p := plot.New()
+	p.X.Min, p.X.Max = 0, 10
+	p.Y.Min, p.Y.Max = 0, 10
+
+	for _, test := range []struct {
+		name      string
+		connector plotter.AnnotationConnector
+		coords    plotter.AnnotationCoordinates
+		target    plotter.XY
+		wantX     float64 // Normalized position of the target.
+		wantY     float64
+		bulge     bool
+	}{
+		{name: "straight", connector: plotter.AnnotationStraight, coords: plotter.AnnotationData, target: plotter.XY{X: 8, Y: 2}, wantX: 0.8, wantY: 0.2},
+		{name: "bezier", connector: plotter.AnnotationBezier, coords: plotter.AnnotationData, target: plotter.XY{X: 8, Y: 2}, wantX: 0.8, wantY: 0.2, bulge: true},
+		{name: "arc_axes", connector: plotter.AnnotationArc, coords: plotter.AnnotationAxes, target: plotter.XY{X: 0.8, Y: 0.2}, wantX: 0.8, wantY: 0.2, bulge: true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			a := plotter.NewAnnotation("text", 2, 2, plotter.AnnotationData)
+			arr := a.SetArrow(test.target.X, test.target.Y, test.coords)
+			arr.Connector = test.connector
+			arr.Bend = 0.3
+
+			boxes := a.GlyphBoxes(p)
+			var head bool
+			maxDev := 0.0
+			for _, b := range boxes[1:] {
+				if b.X == test.wantX && b.Y == test.wantY && b.Size().X >= 2*arr.HeadSize {
+					head = true
+				}
+				maxDev = math.Max(maxDev, math.Abs(b.Y-0.2))
+			}
+			if !head {
+				t.Errorf("missing glyph box of the arrow head at (%v, %v)", test.wantX, test.wantY)
+			}
+			if bulge := maxDev > 0.01; bulge != test.bulge {
+				t.Errorf("unexpected glyph boxes of the arrow path: maximum deviation from the chord %v", maxDev)
+			}
+		})
+	}
+}
+
+func TestAnnotationGlyphBoxesFollowPath(t *testing.T) {
+	p := plot.New()
+	p.X.Min, p.X.Max = 0, 10
+	p.Y.Min, p.Y.Max = 0, 10
+
+	for _, connector := range []plotter.AnnotationConnector{
+		plotter.AnnotationArc,
+		plotter.AnnotationBezier,
+	} {
+		a := plotter.NewAnnotation("text", 2, 2, plotter.AnnotationData)
+		arr := a.SetArrow(8, 6, plotter.AnnotationData)
+		arr.Connector = connector
+		arr.Bend = 0.3
+
+		// The canvas is not square, so that a curve bent in
+		// canvas space differs from one bent in normalized
+		// coordinates.
+		rec := new(recorder.Canvas)
+		c := draw.NewCanvas(rec, 40*vg.Centimeter, 10*vg.Centimeter)
+		a.Plot(c, p)
+
+		var path []vg.Point
+		for _, b := range a.GlyphBoxes(p)[1:] {
+			path = append(path, vg.Point{X: c.X(b.X), Y: c.Y(b.Y)})
+		}
+		// Drop the glyph box of the arrow head.
+		path = path[:len(path)-1]
+
+		var n int
+		for _, act := range rec.Actions {
+			s, ok := act.(*recorder.Stroke)
+			if !ok {
+				continue
+			}
+			for _, comp := range s.Path {
+				n++
+				if d := distance(comp.Pos, path); d > 0.01 {
+					t.Errorf("unexpected distance of the drawn arrow of connector %d from its glyph boxes at %v: %v", connector, comp.Pos, d)
+				}
+			}
+		}
+		if n == 0 {
+			t.Errorf("no arrow drawn for connector %d", connector)
+		}
+	}
+}
+
+// distance returns the distance from pt to the polyline path.
+func distance(pt vg.Point, path []vg.Point) float64 {
+	d := math.Inf(1)
+	for i := 1; i < len(path); i++ {
+		p, q := path[i-1], path[i]
+		seg := q.Sub(p)
+		l2 := float64(seg.Dot(seg))
+		t := 0.0
+		if l2 > 0 {
+			t = math.Max(0, math.Min(1, float64(pt.Sub(p).Dot(seg))/l2))
+		}
+		e := pt.Sub(p.Add(seg.Scale(vg.Length(t))))
+		d = math.Min(d, math.Hypot(float64(e.X), float64(e.Y)))
+	}
+	return d
+}
+
+func TestAnnotationCanvasState(t *testing.T) {
+	p := plot.New()
+	a := plotter.NewAnnotation("text", 0.5, 0.5, plotter.AnnotationAxes)
+	a.Box.FillColor = plotter.DefaultLineStyle.Color
+	a.SetArrow(0.1, 0.1, plotter.AnnotationAxes)
+
+	c := new(recorder.Canvas)
+	a.Plot(draw.NewCanvas(c, 10*vg.Centimeter, 10*vg.Centimeter), p)
+
+	acts := c.Actions
+	if len(acts) < 2 {
+		t.Fatalf("unexpected number of actions: %d", len(acts))
+	}
+	if _, ok := acts[0].(*recorder.Push); !ok {
+		t.Errorf("unexpected first action: got:%v want:Push", acts[0])
+	}
+	if _, ok := acts[len(acts)-1].(*recorder.Pop); !ok {
+		t.Errorf("unexpected last action: got:%v want:Pop", acts[len(acts)-1])
+	}
+}
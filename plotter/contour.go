@@ -44,6 +44,13 @@ type Contour struct {
 	// Min and Max define the dynamic range of the
 	// heat map.
 	Min, Max float64
+
+	// Norm is the normalization of the levels to the
+	// colors of the palette, which span the levels from
+	// the lowest to the highest. If Norm is nil, levels
+	// are mapped linearly. Levels that cannot be
+	// normalized are drawn with the LineStyle color.
+	Norm palette.Normalizer
 }
 
 // NewContour creates as new contour plotter for the given data, using
@@ -148,15 +155,9 @@ func (h *Contour) Plot(c draw.Canvas, plt *plot.Plot) {
 	// optimisations and is necessary for contour fill shading.
 	cp := contourPaths(h.GridXYZ, h.Levels, trX, trY)
 
-	// ps is a palette scaling factor to scale the palette uniformly
-	// across the given levels. This enables a discordance between the
-	// number of colours and the number of levels. Sorting is not
-	// necessary since contourPaths sorts the levels as a side effect.
-	ps := float64(len(pal)-1) / (h.Levels[len(h.Levels)-1] - h.Levels[0])
-	if len(h.Levels) == 1 {
-		ps = 0
-	}
-
+	// The palette is scaled across the given levels. Sorting is
+	// not necessary since contourPaths sorts the levels as a side
+	// effect.
 	for i, z := range h.Levels {
 		if math.IsNaN(z) {
 			continue
@@ -167,17 +168,7 @@ func (h *Contour) Plot(c draw.Canvas, plt *plot.Plot) {
 			}
 
 			style := h.LineStyles[i%len(h.LineStyles)]
-			var col color.Color
-			switch {
-			case z < h.Min:
-				col = h.Underflow
-			case z > h.Max:
-				col = h.Overflow
-			case len(pal) == 0:
-				col = style.Color
-			default:
-				col = pal[int((z-h.Levels[0])*ps+0.5)] // Apply palette scaling.
-			}
+			col := h.color(pal, style, z)
 			if col != nil && style.Width != 0 {
 				c.SetLineStyle(style)
 				c.SetColor(col)
@@ -201,13 +192,6 @@ func (h *Contour) naivePlot(c draw.Canvas, plt *plot.Plot) {
 	// Sort levels prior to palette scaling since we can't depend on
 	// sorting as a side effect from calling contourPaths.
 	sort.Float64s(h.Levels)
-	// ps is a palette scaling factor to scale the palette uniformly
-	// across the given levels. This enables a discordance between the
-	// number of colours and the number of levels.
-	ps := float64(len(pal)-1) / (h.Levels[len(h.Levels)-1] - h.Levels[0])
-	if len(h.Levels) == 1 {
-		ps = 0
-	}
 
 	levelMap := make(map[float64]int)
 	for i, z := range h.Levels {
@@ -237,17 +221,7 @@ func (h *Contour) naivePlot(c draw.Canvas, plt *plot.Plot) {
 		pa.Close()
 
 		style := h.LineStyles[levelMap[z]%len(h.LineStyles)]
-		var col color.Color
-		switch {
-		case z < h.Min:
-			col = h.Underflow
-		case z > h.Max:
-			col = h.Overflow
-		case len(pal) == 0:
-			col = style.Color
-		default:
-			col = pal[int((z-h.Levels[0])*ps+0.5)] // Apply palette scaling.
-		}
+		col := h.color(pal, style, z)
 		if col != nil && style.Width != 0 {
 			c.SetLineStyle(style)
 			c.SetColor(col)
@@ -256,6 +230,32 @@ func (h *Contour) naivePlot(c draw.Canvas, plt *plot.Plot) {
 	})
 }
 
+// color returns the color of the contour at the level z drawn
+// with the style and the palette colors pal, which are scaled
+// uniformly across the sorted levels. This enables a discordance
+// between the number of colours and the number of levels.
+func (h *Contour) color(pal []color.Color, style draw.LineStyle, z float64) color.Color {
+	switch {
+	case z < h.Min:
+		return h.Underflow
+	case z > h.Max:
+		return h.Overflow
+	case len(pal) == 0:
+		return style.Color
+	case len(h.Levels) == 1:
+		return pal[0]
+	}
+	norm := h.Norm
+	if norm == nil {
+		norm = palette.LinearNorm{}
+	}
+	x := norm.Normalize(h.Levels[0], h.Levels[len(h.Levels)-1], z)
+	if math.IsNaN(x) || x < 0 || x > 1 {
+		return style.Color
+	}
+	return pal[int(x*float64(len(pal)-1)+0.5)] // Apply palette scaling.
+}
+
 // DataRange implements the DataRange method
 // of the plot.DataRanger interface.
 func (h *Contour) DataRange() (xmin, xmax, ymin, ymax float64) {
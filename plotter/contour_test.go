@@ -18,6 +18,7 @@ import (
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/palette"
 	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
 )
 
 var visualDebug = flag.Bool("visual", false, "output images for benchmarks and test data")
@@ -480,3 +481,30 @@ type testContour []*contour
 func (c testContour) Len() int           { return len(c) }
 func (c testContour) Less(i, j int) bool { return len(c[i].forward) < len(c[j].forward) }
 func (c testContour) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+
+func TestContourNorm(t *testing.T) {
+	pal := palette.Rainbow(4, palette.Blue, palette.Red, 1, 1, 1).Colors()
+	levels := []float64{1, 10, 100, 1000}
+	for _, test := range []struct {
+		norm palette.Normalizer
+		want []int
+	}{
+		{norm: nil, want: []int{0, 0, 0, 3}},
+		{norm: palette.LinearNorm{}, want: []int{0, 0, 0, 3}},
+		{norm: palette.LogNorm{}, want: []int{0, 1, 2, 3}},
+	} {
+		c := &Contour{
+			Levels:  levels,
+			Palette: palette.Rainbow(4, palette.Blue, palette.Red, 1, 1, 1),
+			Min:     1,
+			Max:     1000,
+			Norm:    test.norm,
+		}
+		for i, z := range levels {
+			got := c.color(pal, draw.LineStyle{}, z)
+			if want := pal[test.want[i]]; got != want {
+				t.Errorf("unexpected color of level %v with norm %T: got=%v, want=%v", z, test.norm, got, want)
+			}
+		}
+	}
+}
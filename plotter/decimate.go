@@ -0,0 +1,214 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"math"
+
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Decimator reduces the number of points drawn by a plotter
+// to the number that can be distinguished at the resolution
+// of the destination canvas.
+type Decimator interface {
+	// Decimate returns the indices, in increasing order,
+	// of the points in pts that should be drawn on c.
+	// The points are given in the draw coordinate
+	// system of c.
+	Decimate(c draw.Canvas, pts []vg.Point) []int
+}
+
+// MinMax is a Decimator for line plots with monotonically
+// increasing X values. For each pixel column of the canvas
+// it keeps the first, last, minimum and maximum points,
+// which renders identically to the full line.
+type MinMax struct {
+	// DPI is the resolution used to compute the
+	// width of a pixel column. If DPI is zero, the
+	// resolution of the canvas is used when it is
+	// known, and 72 otherwise.
+	DPI float64
+}
+
+var _ Decimator = MinMax{}
+
+// Decimate implements the Decimator interface.
+func (d MinMax) Decimate(c draw.Canvas, pts []vg.Point) []int {
+	if len(pts) <= 4 {
+		return seq(len(pts))
+	}
+	px := pixelSize(c, d.DPI)
+
+	idx := make([]int, 0, 4*int((c.Max.X-c.Min.X)/px+1))
+	col := func(x vg.Length) float64 {
+		return math.Floor(float64((x - c.Min.X) / px))
+	}
+	flush := func(first, last, lo, hi int) {
+		bucket := [...]int{first, lo, hi, last}
+		// Sort the (at most) four indices and
+		// drop duplicates.
+		for i := 1; i < len(bucket); i++ {
+			for j := i; j > 0 && bucket[j] < bucket[j-1]; j-- {
+				bucket[j], bucket[j-1] = bucket[j-1], bucket[j]
+			}
+		}
+		for i, v := range bucket {
+			if i > 0 && v == bucket[i-1] {
+				continue
+			}
+			idx = append(idx, v)
+		}
+	}
+
+	var (
+		cur   = col(pts[0].X)
+		first = 0
+		lo    = 0
+		hi    = 0
+	)
+	for i := 1; i < len(pts); i++ {
+		if x := col(pts[i].X); x != cur {
+			flush(first, i-1, lo, hi)
+			cur, first, lo, hi = x, i, i, i
+			continue
+		}
+		if pts[i].Y < pts[lo].Y {
+			lo = i
+		}
+		if pts[i].Y > pts[hi].Y {
+			hi = i
+		}
+	}
+	flush(first, len(pts)-1, lo, hi)
+	return idx
+}
+
+// LTTB is a Decimator implementing the Largest-Triangle-Three-Buckets
+// algorithm described by Sveinn Steinarsson in "Downsampling Time
+// Series for Visual Representation" (2013). The points are split into
+// buckets and, for each bucket, the point forming the largest triangle
+// with the previously selected point and the average of the next
+// bucket is kept. LTTB assumes the X values are monotonically
+// increasing.
+type LTTB struct {
+	// Threshold is the number of points to keep.
+	// If Threshold is zero, two points per pixel
+	// column of the canvas are kept.
+	Threshold int
+
+	// DPI is the resolution used to compute the
+	// number of pixel columns when Threshold is zero.
+	// If DPI is zero, the resolution of the canvas is
+	// used when it is known, and 72 otherwise.
+	DPI float64
+}
+
+var _ Decimator = LTTB{}
+
+// Decimate implements the Decimator interface.
+func (d LTTB) Decimate(c draw.Canvas, pts []vg.Point) []int {
+	n := d.Threshold
+	if n == 0 {
+		n = 2 * int(math.Ceil(float64((c.Max.X-c.Min.X)/pixelSize(c, d.DPI))))
+	}
+	if n >= len(pts) || n < 3 {
+		return seq(len(pts))
+	}
+
+	idx := make([]int, 0, n)
+	idx = append(idx, 0)
+
+	// Bucket size, leaving room for the
+	// first and last points.
+	every := float64(len(pts)-2) / float64(n-2)
+	a := 0
+	for i := range n - 2 {
+		// Average point of the next bucket.
+		lo := int(math.Floor(float64(i+1)*every)) + 1
+		hi := min(int(math.Floor(float64(i+2)*every))+1, len(pts))
+		var avg vg.Point
+		for _, p := range pts[lo:hi] {
+			avg.X += p.X
+			avg.Y += p.Y
+		}
+		avg.X /= vg.Length(hi - lo)
+		avg.Y /= vg.Length(hi - lo)
+
+		// Point of the current bucket forming
+		// the largest triangle.
+		lo = int(math.Floor(float64(i)*every)) + 1
+		hi = int(math.Floor(float64(i+1)*every)) + 1
+		pa := pts[a]
+		area := -1.0
+		for j := lo; j < hi; j++ {
+			p := pts[j]
+			v := math.Abs(float64((pa.X-avg.X)*(p.Y-pa.Y) - (pa.X-p.X)*(avg.Y-pa.Y)))
+			if v > area {
+				area = v
+				a = j
+			}
+		}
+		idx = append(idx, a)
+	}
+	return append(idx, len(pts)-1)
+}
+
+// UniquePixel is a Decimator for scatter plots. It keeps only
+// the first point falling in each pixel of the canvas. When all
+// glyphs share the same style, the result is visually identical
+// to drawing every point.
+type UniquePixel struct {
+	// DPI is the resolution used to compute the
+	// pixel size. If DPI is zero, the resolution
+	// of the canvas is used when it is known, and
+	// 72 otherwise.
+	DPI float64
+}
+
+var _ Decimator = UniquePixel{}
+
+// Decimate implements the Decimator interface.
+func (d UniquePixel) Decimate(c draw.Canvas, pts []vg.Point) []int {
+	px := pixelSize(c, d.DPI)
+	type pixel struct{ x, y int }
+	seen := make(map[pixel]struct{})
+	idx := make([]int, 0, len(pts))
+	for i, p := range pts {
+		k := pixel{
+			x: int(math.Floor(float64((p.X - c.Min.X) / px))),
+			y: int(math.Floor(float64((p.Y - c.Min.Y) / px))),
+		}
+		if _, dup := seen[k]; dup {
+			continue
+		}
+		seen[k] = struct{}{}
+		idx = append(idx, i)
+	}
+	return idx
+}
+
+// pixelSize returns the size of a device pixel of the canvas.
+// If dpi is zero, the resolution of the canvas is used when it
+// implements a DPI method, and 72 otherwise.
+func pixelSize(c draw.Canvas, dpi float64) vg.Length {
+	if dpi == 0 {
+		dpi = 72
+		if r, ok := c.Canvas.(interface{ DPI() float64 }); ok {
+			dpi = r.DPI()
+		}
+	}
+	return vg.Inch / vg.Length(dpi)
+}
+
+// seq returns the indices [0, n).
+func seq(n int) []int {
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	return idx
+}
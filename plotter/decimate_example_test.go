@@ -0,0 +1,46 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+)
+
+// ExampleLine_decimated draws a line with a large number of points,
+// only drawing the points that can be distinguished at the resolution
+// of the output image.
+func ExampleLine_decimated() {
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	const n = 200000
+	pts := make(plotter.XYs, n)
+	for i := range pts {
+		x := 10 * float64(i) / n
+		pts[i].X = x
+		pts[i].Y = math.Sin(x) + 0.2*rnd.NormFloat64()
+	}
+
+	p := plot.New()
+	p.Title.Text = "Decimated line"
+	p.X.Label.Text = "X"
+	p.Y.Label.Text = "Y"
+
+	l, err := plotter.NewLine(pts)
+	if err != nil {
+		log.Panic(err)
+	}
+	l.Decimator = plotter.MinMax{}
+	p.Add(l)
+
+	err = p.Save(300, 200, "testdata/decimatedLine.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
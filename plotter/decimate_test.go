@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestDecimatedLine(t *testing.T) {
+	cmpimg.CheckPlot(ExampleLine_decimated, t, "decimatedLine.png")
+}
+
+func decimationCanvas() draw.Canvas {
+	return draw.Canvas{
+		Canvas: &recorder.Canvas{},
+		Rectangle: vg.Rectangle{
+			Max: vg.Point{X: 100, Y: 100},
+		},
+	}
+}
+
+func sinePoints(n int) []vg.Point {
+	pts := make([]vg.Point, n)
+	for i := range pts {
+		x := 100 * float64(i) / float64(n)
+		pts[i] = vg.Point{X: vg.Length(x), Y: vg.Length(50 + 40*math.Sin(x))}
+	}
+	return pts
+}
+
+func TestMinMax(t *testing.T) {
+	c := decimationCanvas()
+	pts := sinePoints(10000)
+
+	idx := plotter.MinMax{}.Decimate(c, pts)
+	if len(idx) > 4*101 {
+		t.Errorf("too many points: got=%d, want<=%d", len(idx), 4*101)
+	}
+	if !slices.IsSorted(idx) {
+		t.Errorf("indices are not sorted")
+	}
+	if idx[0] != 0 || idx[len(idx)-1] != len(pts)-1 {
+		t.Errorf("end points not preserved: got=[%d, %d]", idx[0], idx[len(idx)-1])
+	}
+
+	// Each pixel column must keep its extrema.
+	col := func(p vg.Point) int { return int(math.Floor(float64(p.X))) }
+	type extrema struct{ lo, hi vg.Length }
+	want := make(map[int]extrema)
+	for _, p := range pts {
+		e, ok := want[col(p)]
+		if !ok {
+			e = extrema{lo: p.Y, hi: p.Y}
+		}
+		e.lo = min(e.lo, p.Y)
+		e.hi = max(e.hi, p.Y)
+		want[col(p)] = e
+	}
+	got := make(map[int]extrema)
+	for _, i := range idx {
+		p := pts[i]
+		e, ok := got[col(p)]
+		if !ok {
+			e = extrema{lo: p.Y, hi: p.Y}
+		}
+		e.lo = min(e.lo, p.Y)
+		e.hi = max(e.hi, p.Y)
+		got[col(p)] = e
+	}
+	for k, w := range want {
+		if g := got[k]; g != w {
+			t.Errorf("unexpected extrema for column %d: got=%v, want=%v", k, g, w)
+		}
+	}
+}
+
+func TestLTTB(t *testing.T) {
+	c := decimationCanvas()
+	pts := sinePoints(10000)
+
+	for _, test := range []struct {
+		d    plotter.LTTB
+		want int
+	}{
+		{d: plotter.LTTB{}, want: 200},
+		{d: plotter.LTTB{DPI: 144}, want: 400},
+		{d: plotter.LTTB{Threshold: 50}, want: 50},
+		{d: plotter.LTTB{Threshold: 20000}, want: 10000},
+	} {
+		idx := test.d.Decimate(c, pts)
+		if len(idx) != test.want {
+			t.Errorf("unexpected number of points for %+v: got=%d, want=%d", test.d, len(idx), test.want)
+		}
+		if !slices.IsSorted(idx) {
+			t.Errorf("indices are not sorted for %+v", test.d)
+		}
+		if idx[0] != 0 || idx[len(idx)-1] != len(pts)-1 {
+			t.Errorf("end points not preserved for %+v", test.d)
+		}
+	}
+}
+
+func TestUniquePixel(t *testing.T) {
+	c := decimationCanvas()
+	pts := []vg.Point{
+		{X: 0.1, Y: 0.1},
+		{X: 0.9, Y: 0.9},
+		{X: 1.1, Y: 0.1},
+		{X: 0.5, Y: 0.5},
+		{X: 10, Y: 10},
+	}
+	got := plotter.UniquePixel{}.Decimate(c, pts)
+	want := []int{0, 2, 4}
+	if !slices.Equal(got, want) {
+		t.Errorf("unexpected indices: got=%v, want=%v", got, want)
+	}
+}
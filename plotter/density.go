@@ -0,0 +1,486 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"runtime"
+	"sync"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Aggregation specifies how the points falling in the same
+// pixel of a Density plot are combined.
+type Aggregation int
+
+const (
+	// CountAggregation colors each pixel by the
+	// number of points it contains.
+	CountAggregation Aggregation = iota
+
+	// MeanAggregation colors each pixel by the mean
+	// of the Z values of the points it contains.
+	MeanAggregation
+
+	// CategoricalAggregation colors each pixel by the mix
+	// of the colors of the categories of the points it
+	// contains, weighted by the number of points of each
+	// category. The Z value of a point is the index of its
+	// category in the Categories of the Density.
+	CategoricalAggregation
+)
+
+// Density implements the Plotter interface, drawing a density
+// raster of a large number of points. The points are aggregated
+// at draw time into a grid of pixels matching the resolution of
+// the canvas, and the grid is drawn as a single image.
+type Density struct {
+	// Data holds the points of the plot. The data is not
+	// copied, and is streamed in chunks at draw time by
+	// several goroutines, so its XY and XYZ methods must
+	// be safe for concurrent use. Data must implement XYZer
+	// when MeanAggregation or CategoricalAggregation is used.
+	Data XYer
+
+	// Aggregation is the aggregation used to
+	// combine the points falling in a pixel.
+	Aggregation Aggregation
+
+	// ColorMap is used to color the aggregated values.
+	// The aggregated values are rescaled to the range of
+	// the ColorMap.
+	ColorMap palette.ColorMap
+
+	// Norm is the normalization of the aggregated values
+	// between their minimum and maximum. The minimum of a
+	// palette.LogNorm is that of the positive values, and
+	// a palette.EqHistNorm with no Values equalizes the
+	// histogram of the aggregated values. Pixels with values
+	// that are not normalized within [0, 1] are not drawn.
+	// If Norm is nil, values are normalized linearly.
+	Norm palette.Normalizer
+
+	// Categories holds the color of each category
+	// used by CategoricalAggregation.
+	Categories []color.Color
+
+	// Spread is the radius, in pixels, by which the
+	// contribution of each pixel is spread to its
+	// neighbours, making isolated points more visible.
+	Spread int
+
+	// DPI is the resolution of the density raster.
+	// If DPI is zero, the resolution of the canvas
+	// is used when it is known, and 72 otherwise.
+	DPI float64
+
+	// Workers is the number of goroutines used to
+	// aggregate the points. If Workers is zero,
+	// runtime.GOMAXPROCS(0) is used. Each goroutine
+	// aggregates the points into its own grid of pixels,
+	// so fewer goroutines are used for large grids.
+	Workers int
+
+	// ChunkSize is the number of points handled by
+	// a worker at a time. If ChunkSize is zero, a
+	// default of 65536 is used.
+	ChunkSize int
+
+	xmin, xmax, ymin, ymax float64
+}
+
+// NewDensity returns a Density plotting the points of data with the
+// given color map, using CountAggregation and a linear normalization.
+// The data range is computed once, but the data is not copied.
+// If cmap has an empty range, its range is set to [0, 1].
+func NewDensity(data XYer, cmap palette.ColorMap) (*Density, error) {
+	if data.Len() == 0 {
+		return nil, ErrNoData
+	}
+	if cmap != nil && cmap.Min() == cmap.Max() {
+		cmap.SetMin(0)
+		cmap.SetMax(1)
+	}
+	d := &Density{
+		Data:     data,
+		ColorMap: cmap,
+		xmin:     math.Inf(+1),
+		xmax:     math.Inf(-1),
+		ymin:     math.Inf(+1),
+		ymax:     math.Inf(-1),
+	}
+	var (
+		mu   sync.Mutex
+		errs []error
+	)
+	d.stream(d.workers(), func(_, lo, hi int) {
+		xmin, xmax := math.Inf(+1), math.Inf(-1)
+		ymin, ymax := math.Inf(+1), math.Inf(-1)
+		var err error
+		for i := lo; i < hi; i++ {
+			x, y := data.XY(i)
+			if err = CheckFloats(x, y); err != nil {
+				break
+			}
+			xmin, xmax = math.Min(xmin, x), math.Max(xmax, x)
+			ymin, ymax = math.Min(ymin, y), math.Max(ymax, y)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs = append(errs, err)
+		}
+		d.xmin, d.xmax = math.Min(d.xmin, xmin), math.Max(d.xmax, xmax)
+		d.ymin, d.ymax = math.Min(d.ymin, ymin), math.Max(d.ymax, ymax)
+	})
+	if len(errs) != 0 {
+		return nil, errs[0]
+	}
+	return d, nil
+}
+
+// densityCells is the maximum total number of accumulators
+// of the grids of the goroutines aggregating a Density.
+const densityCells = 1 << 24
+
+// workers returns the number of goroutines used to stream the data.
+func (d *Density) workers() int {
+	if d.Workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return d.Workers
+}
+
+// gridWorkers returns the number of goroutines aggregating the data
+// into grids of the given number of accumulators, capped so that the
+// grids hold at most densityCells accumulators, and at least one.
+func (d *Density) gridWorkers(cells int) int {
+	return max(1, min(d.workers(), densityCells/cells))
+}
+
+// stream calls fn concurrently on consecutive chunks [lo, hi)
+// of the data, with the given number of goroutines. Calls made
+// by the same goroutine share the same worker index w, in
+// [0, workers).
+func (d *Density) stream(workers int, fn func(w, lo, hi int)) {
+	size := d.ChunkSize
+	if size <= 0 {
+		size = 1 << 16
+	}
+
+	n := d.Data.Len()
+	chunks := make(chan int)
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for lo := range chunks {
+				fn(w, lo, min(lo+size, n))
+			}
+		}()
+	}
+	for lo := 0; lo < n; lo += size {
+		chunks <- lo
+	}
+	close(chunks)
+	wg.Wait()
+}
+
+// densityGrid holds the per-pixel accumulators of a Density.
+type densityGrid struct {
+	cols, rows int
+	count      []float64
+	sum        []float64
+	cats       [][]float64
+}
+
+func newDensityGrid(cols, rows int, agg Aggregation, ncats int) *densityGrid {
+	g := &densityGrid{
+		cols:  cols,
+		rows:  rows,
+		count: make([]float64, cols*rows),
+	}
+	switch agg {
+	case MeanAggregation:
+		g.sum = make([]float64, cols*rows)
+	case CategoricalAggregation:
+		g.cats = make([][]float64, ncats)
+		for i := range g.cats {
+			g.cats[i] = make([]float64, cols*rows)
+		}
+	}
+	return g
+}
+
+// cells returns the number of accumulators of g.
+func (g *densityGrid) cells() int {
+	return len(g.count) + len(g.sum) + len(g.cats)*len(g.count)
+}
+
+// add accumulates the values of o into g.
+func (g *densityGrid) add(o *densityGrid) {
+	for i, v := range o.count {
+		g.count[i] += v
+	}
+	for i, v := range o.sum {
+		g.sum[i] += v
+	}
+	for k := range o.cats {
+		for i, v := range o.cats[k] {
+			g.cats[k][i] += v
+		}
+	}
+}
+
+// spread replaces each accumulator by its box sum over
+// a square of the given radius around each pixel.
+func (g *densityGrid) spread(r int) {
+	box := func(src []float64) []float64 {
+		dst := make([]float64, len(src))
+		for j := range g.rows {
+			for i := range g.cols {
+				v := src[j*g.cols+i]
+				if v == 0 {
+					continue
+				}
+				for jj := max(j-r, 0); jj <= min(j+r, g.rows-1); jj++ {
+					for ii := max(i-r, 0); ii <= min(i+r, g.cols-1); ii++ {
+						dst[jj*g.cols+ii] += v
+					}
+				}
+			}
+		}
+		return dst
+	}
+	g.count = box(g.count)
+	if g.sum != nil {
+		g.sum = box(g.sum)
+	}
+	for k := range g.cats {
+		g.cats[k] = box(g.cats[k])
+	}
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+func (d *Density) Plot(c draw.Canvas, plt *plot.Plot) {
+	if d.ColorMap == nil && d.Aggregation != CategoricalAggregation {
+		panic("plotter: nil ColorMap in Density")
+	}
+	var xyz XYZer
+	if d.Aggregation != CountAggregation {
+		var ok bool
+		xyz, ok = d.Data.(XYZer)
+		if !ok {
+			panic("plotter: Density aggregation requires XYZer data")
+		}
+	}
+
+	px := pixelSize(c, d.DPI)
+	cols := int(math.Ceil(float64((c.Max.X - c.Min.X) / px)))
+	rows := int(math.Ceil(float64((c.Max.Y - c.Min.Y) / px)))
+	if cols <= 0 || rows <= 0 {
+		return
+	}
+
+	grids := []*densityGrid{newDensityGrid(cols, rows, d.Aggregation, len(d.Categories))}
+	for range d.gridWorkers(grids[0].cells()) - 1 {
+		grids = append(grids, newDensityGrid(cols, rows, d.Aggregation, len(d.Categories)))
+	}
+	d.stream(len(grids), func(w, lo, hi int) {
+		local := grids[w]
+		for i := lo; i < hi; i++ {
+			x, y := d.Data.XY(i)
+			nx, ny := plt.X.Norm(x), plt.Y.Norm(y)
+			if nx < 0 || nx > 1 || ny < 0 || ny > 1 {
+				continue
+			}
+			ix := min(int(nx*float64(cols)), cols-1)
+			iy := min(int(ny*float64(rows)), rows-1)
+			k := iy*cols + ix
+			switch d.Aggregation {
+			case CountAggregation:
+				local.count[k]++
+			case MeanAggregation:
+				_, _, z := xyz.XYZ(i)
+				if math.IsNaN(z) {
+					continue
+				}
+				local.count[k]++
+				local.sum[k] += z
+			case CategoricalAggregation:
+				_, _, z := xyz.XYZ(i)
+				cat := int(z)
+				if cat < 0 || cat >= len(local.cats) {
+					continue
+				}
+				local.count[k]++
+				local.cats[cat][k]++
+			}
+		}
+	})
+	grid := grids[0]
+	for _, g := range grids[1:] {
+		grid.add(g)
+	}
+	if d.Spread > 0 {
+		grid.spread(d.Spread)
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, cols, rows))
+	if d.Aggregation == CategoricalAggregation {
+		d.shadeCategories(img, grid)
+	} else {
+		d.shade(img, grid)
+	}
+	c.DrawImage(c.Rectangle, img)
+}
+
+// shade colors img using the color map of d.
+func (d *Density) shade(img *image.NRGBA, g *densityGrid) {
+	vs := make([]float64, len(g.count))
+	for i, n := range g.count {
+		switch {
+		case n == 0:
+			vs[i] = math.NaN()
+		case d.Aggregation == MeanAggregation:
+			vs[i] = g.sum[i] / n
+		default:
+			vs[i] = n
+		}
+	}
+	norm := d.normalizer(vs)
+
+	for j := range g.rows {
+		for i := range g.cols {
+			t := norm(vs[j*g.cols+i])
+			if math.IsNaN(t) {
+				continue
+			}
+			col, err := palette.ColorAt(d.ColorMap, t)
+			if err != nil {
+				continue
+			}
+			img.Set(i, g.rows-j-1, col)
+		}
+	}
+}
+
+// shadeCategories colors img with the mix of category colors,
+// with an opacity given by the normalized count.
+func (d *Density) shadeCategories(img *image.NRGBA, g *densityGrid) {
+	vs := make([]float64, len(g.count))
+	for i, n := range g.count {
+		vs[i] = n
+		if n == 0 {
+			vs[i] = math.NaN()
+		}
+	}
+	norm := d.normalizer(vs)
+
+	// minAlpha ensures pixels with few points are visible.
+	const minAlpha = 0.25
+	for j := range g.rows {
+		for i := range g.cols {
+			k := j*g.cols + i
+			t := norm(vs[k])
+			if math.IsNaN(t) {
+				continue
+			}
+			var r, gr, b float64
+			for cat, counts := range g.cats {
+				if counts[k] == 0 {
+					continue
+				}
+				w := counts[k] / g.count[k]
+				cr, cg, cb, _ := d.Categories[cat].RGBA()
+				r += w * float64(cr)
+				gr += w * float64(cg)
+				b += w * float64(cb)
+			}
+			img.SetNRGBA(i, g.rows-j-1, color.NRGBA{
+				R: uint8(r / 0x101),
+				G: uint8(gr / 0x101),
+				B: uint8(b / 0x101),
+				A: uint8(255 * (minAlpha + (1-minAlpha)*t)),
+			})
+		}
+	}
+}
+
+// normalizer returns a function mapping the non-NaN values of vs
+// to [0, 1] according to the Norm of d. Values that cannot be
+// normalized within [0, 1] are mapped to NaN.
+func (d *Density) normalizer(vs []float64) func(float64) float64 {
+	norm := d.Norm
+	if norm == nil {
+		norm = palette.LinearNorm{}
+	}
+	_, log := norm.(palette.LogNorm)
+	lo, hi := math.Inf(+1), math.Inf(-1)
+	for _, v := range vs {
+		if math.IsNaN(v) || log && v <= 0 {
+			continue
+		}
+		lo, hi = math.Min(lo, v), math.Max(hi, v)
+	}
+	if eq, ok := norm.(palette.EqHistNorm); ok && eq.Values == nil {
+		norm = palette.NewEqHistNorm(vs)
+	}
+
+	return func(v float64) float64 {
+		switch {
+		case math.IsNaN(v):
+			return v
+		case hi == lo:
+			if v == lo {
+				return 1
+			}
+			return math.NaN()
+		}
+		x := norm.Normalize(lo, hi, v)
+		if x < 0 || x > 1 {
+			return math.NaN()
+		}
+		return x
+	}
+}
+
+// DataRange implements the DataRange method
+// of the plot.DataRanger interface.
+func (d *Density) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return d.xmin, d.xmax, d.ymin, d.ymax
+}
+
+// Thumbnail implements the Thumbnail method
+// of the plot.Thumbnailer interface.
+func (d *Density) Thumbnail(c *draw.Canvas) {
+	var col color.Color
+	switch {
+	case d.Aggregation == CategoricalAggregation && len(d.Categories) > 0:
+		col = d.Categories[0]
+	case d.ColorMap != nil:
+		var err error
+		col, err = d.ColorMap.At(0.5 * (d.ColorMap.Min() + d.ColorMap.Max()))
+		if err != nil {
+			return
+		}
+	default:
+		return
+	}
+	pts := []vg.Point{
+		{X: c.Min.X, Y: c.Min.Y},
+		{X: c.Min.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Max.Y},
+		{X: c.Max.X, Y: c.Min.Y},
+	}
+	c.FillPolygon(col, c.ClipPolygonY(pts))
+}
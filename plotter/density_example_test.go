@@ -0,0 +1,84 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+	"math/rand/v2"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+)
+
+// ExampleDensity draws the density of a large number of
+// normally distributed points.
+func ExampleDensity() {
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	const n = 500000
+	pts := make(plotter.XYs, n)
+	for i := range pts {
+		pts[i].X = rnd.NormFloat64()
+		pts[i].Y = 0.5*pts[i].X + rnd.NormFloat64()
+	}
+
+	p := plot.New()
+	p.Title.Text = "Density"
+	p.X.Label.Text = "X"
+	p.Y.Label.Text = "Y"
+
+	d, err := plotter.NewDensity(pts, moreland.Kindlmann())
+	if err != nil {
+		log.Panic(err)
+	}
+	d.Norm = palette.EqHistNorm{}
+	p.Add(d)
+
+	err = p.Save(250, 250, "testdata/density.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+// ExampleDensity_categorical draws the density of points
+// from two categories, colored by their mix.
+func ExampleDensity_categorical() {
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	const n = 200000
+	pts := make(plotter.XYZs, n)
+	for i := range pts {
+		cat := i % 2
+		pts[i].X = rnd.NormFloat64() + float64(cat)
+		pts[i].Y = rnd.NormFloat64()
+		pts[i].Z = float64(cat)
+	}
+
+	p := plot.New()
+	p.Title.Text = "Categorical density"
+	p.X.Label.Text = "X"
+	p.Y.Label.Text = "Y"
+
+	d, err := plotter.NewDensity(pts, nil)
+	if err != nil {
+		log.Panic(err)
+	}
+	d.Aggregation = plotter.CategoricalAggregation
+	d.Norm = palette.LogNorm{}
+	d.Categories = []color.Color{
+		color.RGBA{R: 230, G: 30, B: 30, A: 255},
+		color.RGBA{R: 30, G: 30, B: 230, A: 255},
+	}
+	d.Spread = 1
+	p.Add(d)
+
+	err = p.Save(250, 250, "testdata/density_categorical.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
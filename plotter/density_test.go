@@ -0,0 +1,238 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"slices"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestDensity(t *testing.T) {
+	cmpimg.CheckPlot(ExampleDensity, t, "density.png")
+	cmpimg.CheckPlot(ExampleDensity_categorical, t, "density_categorical.png")
+}
+
+func TestNewDensity(t *testing.T) {
+	pts := make(plotter.XYs, 100000)
+	for i := range pts {
+		pts[i].X = float64(i)
+		pts[i].Y = -float64(i)
+	}
+	d, err := plotter.NewDensity(pts, moreland.Kindlmann())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	xmin, xmax, ymin, ymax := d.DataRange()
+	if xmin != 0 || xmax != 99999 || ymin != -99999 || ymax != 0 {
+		t.Errorf("unexpected data range: got=[%v, %v]x[%v, %v]", xmin, xmax, ymin, ymax)
+	}
+
+	pts[70000].Y = math.NaN()
+	_, err = plotter.NewDensity(pts, moreland.Kindlmann())
+	if err != plotter.ErrNaN {
+		t.Errorf("unexpected error: got=%v, want=%v", err, plotter.ErrNaN)
+	}
+
+	_, err = plotter.NewDensity(plotter.XYs{}, moreland.Kindlmann())
+	if err != plotter.ErrNoData {
+		t.Errorf("unexpected error: got=%v, want=%v", err, plotter.ErrNoData)
+	}
+}
+
+func TestDensityNorm(t *testing.T) {
+	pts := make(plotter.XYs, 1000)
+	for i := range pts {
+		pts[i].X = float64(i % 10)
+		pts[i].Y = float64(i % 7)
+	}
+	for _, test := range []struct {
+		norm  palette.Normalizer
+		drawn bool
+	}{
+		{norm: nil, drawn: true},
+		{norm: palette.PowerNorm{Gamma: 0.5}, drawn: true},
+		// All the counts are below the boundaries.
+		{norm: palette.BoundaryNorm{Boundaries: []float64{1e6, 2e6}}, drawn: false},
+	} {
+		d, err := plotter.NewDensity(pts, moreland.Kindlmann())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		d.Norm = test.norm
+
+		rec := new(recorder.Canvas)
+		p := plot.New()
+		p.Add(d)
+		p.Draw(draw.NewCanvas(rec, vg.Points(100), vg.Points(100)))
+
+		var drawn bool
+		for _, a := range rec.Actions {
+			a, ok := a.(*recorder.DrawImage)
+			if !ok {
+				continue
+			}
+			b := a.Image.Bounds()
+			for x := b.Min.X; x < b.Max.X; x++ {
+				for y := b.Min.Y; y < b.Max.Y; y++ {
+					if _, _, _, alpha := a.Image.At(x, y).RGBA(); alpha != 0 {
+						drawn = true
+					}
+				}
+			}
+		}
+		if drawn != test.drawn {
+			t.Errorf("unexpected drawing with norm %T: got=%t, want=%t", test.norm, drawn, test.drawn)
+		}
+	}
+}
+
+func TestDensityPixels(t *testing.T) {
+	const undrawn = -1
+	for _, test := range []struct {
+		name   string
+		agg    plotter.Aggregation
+		norm   palette.Normalizer
+		spread int
+		// zs holds the Z values of the points
+		// falling in each pixel of the density.
+		zs   [][]float64
+		want []int
+	}{
+		{
+			name: "count",
+			zs:   [][]float64{{0}, {0, 0}, nil, {0, 0, 0, 0}},
+			want: []int{0, 85, undrawn, 255},
+		},
+		{
+			name: "mean",
+			agg:  plotter.MeanAggregation,
+			zs:   [][]float64{{1, 3}, {4}, nil, {0, 2, math.NaN()}},
+			want: []int{85, 255, undrawn, 0},
+		},
+		{
+			name: "mean_nan",
+			agg:  plotter.MeanAggregation,
+			zs:   [][]float64{{math.NaN()}, {1}, {2}, {3}},
+			want: []int{undrawn, 0, 128, 255},
+		},
+		{
+			name:   "spread",
+			spread: 1,
+			zs:     [][]float64{{0}, nil, nil, {0, 0}},
+			want:   []int{0, 0, 255, 255},
+		},
+		{
+			name: "log",
+			norm: palette.LogNorm{},
+			zs:   [][]float64{{0}, make([]float64, 10), nil, make([]float64, 100)},
+			want: []int{0, 128, undrawn, 255},
+		},
+		{
+			name: "log_mean",
+			agg:  plotter.MeanAggregation,
+			norm: palette.LogNorm{},
+			zs:   [][]float64{{-1}, {1}, {0}, {100}},
+			want: []int{undrawn, 0, undrawn, 255},
+		},
+		{
+			name: "eq_hist",
+			norm: palette.EqHistNorm{},
+			zs:   [][]float64{{0}, {0, 0}, nil, make([]float64, 40)},
+			want: []int{0, 128, undrawn, 255},
+		},
+		{
+			name: "eq_hist_mean",
+			agg:  plotter.MeanAggregation,
+			norm: palette.EqHistNorm{},
+			zs:   [][]float64{{1}, {1000}, {2}, {10}},
+			want: []int{0, 255, 85, 170},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			// Place the points at the centers of
+			// a row of pixels of one point.
+			var pts plotter.XYZs
+			for i, zs := range test.zs {
+				for _, z := range zs {
+					pts = append(pts, plotter.XYZ{X: float64(i) + 0.5, Y: 0.5, Z: z})
+				}
+			}
+			d, err := plotter.NewDensity(pts, &grayMap{max: 1})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			d.Aggregation = test.agg
+			d.Norm = test.norm
+			d.Spread = test.spread
+			d.DPI = 72
+			d.Workers = 3
+			d.ChunkSize = 2
+
+			p := plot.New()
+			p.X.Min, p.X.Max = 0, float64(len(test.zs))
+			p.Y.Min, p.Y.Max = 0, 1
+			rec := new(recorder.Canvas)
+			d.Plot(draw.NewCanvas(rec, vg.Points(float64(len(test.zs))), vg.Points(1)), p)
+
+			var img image.Image
+			for _, a := range rec.Actions {
+				if a, ok := a.(*recorder.DrawImage); ok {
+					img = a.Image
+				}
+			}
+			if img == nil {
+				t.Fatalf("no density image drawn")
+			}
+			got := make([]int, img.Bounds().Dx())
+			for i := range got {
+				c := color.NRGBAModel.Convert(img.At(i, 0)).(color.NRGBA)
+				got[i] = int(c.R)
+				if c.A == 0 {
+					got[i] = undrawn
+				}
+			}
+			if !slices.Equal(got, test.want) {
+				t.Errorf("unexpected pixels: got=%v, want=%v", got, test.want)
+			}
+		})
+	}
+}
+
+// grayMap is a ColorMap going linearly from black to white.
+type grayMap struct {
+	min, max float64
+}
+
+func (m *grayMap) At(v float64) (color.Color, error) {
+	switch {
+	case math.IsNaN(v):
+		return nil, palette.ErrNaN
+	case v < m.min:
+		return nil, palette.ErrUnderflow
+	case v > m.max:
+		return nil, palette.ErrOverflow
+	}
+	return color.Gray{Y: uint8(math.Round(255 * (v - m.min) / (m.max - m.min)))}, nil
+}
+
+func (m *grayMap) Max() float64                { return m.max }
+func (m *grayMap) SetMax(v float64)            { m.max = v }
+func (m *grayMap) Min() float64                { return m.min }
+func (m *grayMap) SetMin(v float64)            { m.min = v }
+func (m *grayMap) Alpha() float64              { return 1 }
+func (m *grayMap) SetAlpha(float64)            {}
+func (m *grayMap) Palette(int) palette.Palette { return nil }
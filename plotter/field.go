@@ -5,9 +5,11 @@
 package plotter
 
 import (
+	"image/color"
 	"math"
 
 	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
 	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
 )
@@ -49,8 +51,8 @@ type Field struct {
 	// but should not be used to determine size or
 	// directions of the glyph.
 	//
-	// If DrawGlyph is nil, a simple arrow will be
-	// drawn.
+	// If DrawGlyph is nil, an arrow with a filled
+	// head will be drawn.
 	DrawGlyph func(c vg.Canvas, sty draw.LineStyle, v XY)
 
 	// LineStyle is the style of the line used to
@@ -58,13 +60,28 @@ type Field struct {
 	// Otherwise it is passed to DrawGlyph.
 	LineStyle draw.LineStyle
 
-	// max define the dynamic range of the field.
-	max float64
+	// Scale is the magnitude of a vector drawn with a
+	// length of one data unit. If Scale is zero, vectors
+	// are scaled so that the longest vector spans half
+	// of a grid cell.
+	Scale float64
+
+	// ColorMap, if not nil, is used to color each vector
+	// according to its magnitude. The magnitude range of
+	// the field is rescaled to the range of the ColorMap.
+	ColorMap palette.ColorMap
+
+	// ColorFunc, if not nil, returns the color of the
+	// vector at (c, r), taking precedence over ColorMap.
+	ColorFunc func(c, r int) color.Color
+
+	// min and max define the dynamic range of the field.
+	min, max float64
 }
 
 // NewField creates a new vector field plotter.
 func NewField(f FieldXY) *Field {
-	max := math.Inf(-1)
+	min, max := math.Inf(+1), math.Inf(-1)
 	c, r := f.Dims()
 	for i := range c {
 		for j := range r {
@@ -73,6 +90,7 @@ func NewField(f FieldXY) *Field {
 			if math.IsNaN(d) {
 				continue
 			}
+			min = math.Min(min, d)
 			max = math.Max(max, d)
 		}
 	}
@@ -80,6 +98,7 @@ func NewField(f FieldXY) *Field {
 	return &Field{
 		FieldXY:   f,
 		LineStyle: DefaultLineStyle,
+		min:       min,
 		max:       max,
 	}
 }
@@ -94,41 +113,8 @@ func (f *Field) Plot(c draw.Canvas, plt *plot.Plot) {
 
 	cols, rows := f.FieldXY.Dims()
 	for i := range cols {
-		var right, left float64
-		switch i {
-		case 0:
-			if cols == 1 {
-				right = 0.5
-			} else {
-				right = (f.FieldXY.X(1) - f.FieldXY.X(0)) / 2
-			}
-			left = -right
-		case cols - 1:
-			right = (f.FieldXY.X(cols-1) - f.FieldXY.X(cols-2)) / 2
-			left = -right
-		default:
-			right = (f.FieldXY.X(i+1) - f.FieldXY.X(i)) / 2
-			left = -(f.FieldXY.X(i) - f.FieldXY.X(i-1)) / 2
-		}
-
 		for j := range rows {
-			var up, down float64
-			switch j {
-			case 0:
-				if rows == 1 {
-					up = 0.5
-				} else {
-					up = (f.FieldXY.Y(1) - f.FieldXY.Y(0)) / 2
-				}
-				down = -up
-			case rows - 1:
-				up = (f.FieldXY.Y(rows-1) - f.FieldXY.Y(rows-2)) / 2
-				down = -up
-			default:
-				up = (f.FieldXY.Y(j+1) - f.FieldXY.Y(j)) / 2
-				down = -(f.FieldXY.Y(j) - f.FieldXY.Y(j-1)) / 2
-			}
-
+			left, right, down, up := f.cell(i, j)
 			x, y := trX(f.FieldXY.X(i)+left), trY(f.FieldXY.Y(j)+down)
 			dx, dy := trX(f.FieldXY.X(i)+right), trY(f.FieldXY.Y(j)+up)
 
@@ -136,16 +122,22 @@ func (f *Field) Plot(c draw.Canvas, plt *plot.Plot) {
 				continue
 			}
 
+			v := f.FieldXY.Vector(i, j)
+			sty := f.LineStyle
+
 			c.Push()
+			if col := f.color(i, j, v); col != nil {
+				sty.Color = col
+				c.SetColor(col)
+			}
 			c.Translate(vg.Point{X: (x + dx) / 2, Y: (y + dy) / 2})
 
-			v := f.FieldXY.Vector(i, j)
-			s := math.Hypot(v.X, v.Y) / (2 * f.max)
+			rot, sx, sy := f.transform(trX, trY, f.FieldXY.X(i), f.FieldXY.Y(j), dx-x, dy-y, v)
 			// Do not scale when the vector is zero, otherwise the
 			// user cannot render special-case glyphs for that case.
-			if s != 0 {
-				c.Rotate(math.Atan2(v.Y, v.X))
-				c.Scale(s*float64(dx-x), s*float64(dy-y))
+			if sx != 0 {
+				c.Rotate(rot)
+				c.Scale(sx, sy)
 			}
 			v.X /= f.max
 			v.Y /= f.max
@@ -153,23 +145,110 @@ func (f *Field) Plot(c draw.Canvas, plt *plot.Plot) {
 			if f.DrawGlyph == nil {
 				drawVector(c, v)
 			} else {
-				f.DrawGlyph(c, f.LineStyle, v)
+				f.DrawGlyph(c, sty, v)
 			}
 			c.Pop()
 		}
 	}
 }
 
+// cell returns the offsets from the grid node at (i, j)
+// to the edges of its grid cell.
+func (f *Field) cell(i, j int) (left, right, down, up float64) {
+	cols, rows := f.FieldXY.Dims()
+	switch i {
+	case 0:
+		if cols == 1 {
+			right = 0.5
+		} else {
+			right = (f.FieldXY.X(1) - f.FieldXY.X(0)) / 2
+		}
+		left = -right
+	case cols - 1:
+		right = (f.FieldXY.X(cols-1) - f.FieldXY.X(cols-2)) / 2
+		left = -right
+	default:
+		right = (f.FieldXY.X(i+1) - f.FieldXY.X(i)) / 2
+		left = -(f.FieldXY.X(i) - f.FieldXY.X(i-1)) / 2
+	}
+
+	switch j {
+	case 0:
+		if rows == 1 {
+			up = 0.5
+		} else {
+			up = (f.FieldXY.Y(1) - f.FieldXY.Y(0)) / 2
+		}
+		down = -up
+	case rows - 1:
+		up = (f.FieldXY.Y(rows-1) - f.FieldXY.Y(rows-2)) / 2
+		down = -up
+	default:
+		up = (f.FieldXY.Y(j+1) - f.FieldXY.Y(j)) / 2
+		down = -(f.FieldXY.Y(j) - f.FieldXY.Y(j-1)) / 2
+	}
+	return left, right, down, up
+}
+
+// transform returns the rotation and scaling of the glyph of
+// the vector v located at (x, y) in data coordinates, in a grid
+// cell of canvas size (w, h). The scaling is zero when the
+// vector is zero.
+func (f *Field) transform(trX, trY func(float64) vg.Length, x, y float64, w, h vg.Length, v XY) (rot, sx, sy float64) {
+	if f.Scale == 0 {
+		s := math.Hypot(v.X, v.Y) / (2 * f.max)
+		return math.Atan2(v.Y, v.X), s * float64(w), s * float64(h)
+	}
+	ex := trX(x+v.X/f.Scale) - trX(x)
+	ey := trY(y+v.Y/f.Scale) - trY(y)
+	l := math.Hypot(float64(ex), float64(ey))
+	return math.Atan2(float64(ey), float64(ex)), l, l * float64(h/w)
+}
+
+// color returns the color of the vector v at (i, j),
+// or nil if the color of the LineStyle should be used.
+func (f *Field) color(i, j int, v XY) color.Color {
+	switch {
+	case f.ColorFunc != nil:
+		return f.ColorFunc(i, j)
+	case f.ColorMap != nil:
+		t := 0.0
+		if f.max > f.min {
+			t = (math.Hypot(v.X, v.Y) - f.min) / (f.max - f.min)
+		}
+		if math.IsNaN(t) {
+			return nil
+		}
+		col, err := palette.ColorAt(f.ColorMap, t)
+		if err != nil {
+			return nil
+		}
+		return col
+	}
+	return nil
+}
+
+// drawVector draws a unit vector to (1, 0) with a filled
+// arrow head, using the current line style and color of c.
 func drawVector(c vg.Canvas, v XY) {
 	if math.Hypot(v.X, v.Y) == 0 {
 		return
 	}
-	// TODO(kortschak): Improve this arrow.
+	const (
+		headLength = 0.25
+		headWidth  = 0.1
+	)
 	var pa vg.Path
 	pa.Move(vg.Point{})
-	pa.Line(vg.Point{X: 1, Y: 0})
-	pa.Close()
+	pa.Line(vg.Point{X: 1 - headLength, Y: 0})
 	c.Stroke(pa)
+
+	pa = pa[:0]
+	pa.Move(vg.Point{X: 1, Y: 0})
+	pa.Line(vg.Point{X: 1 - headLength, Y: headWidth})
+	pa.Line(vg.Point{X: 1 - headLength, Y: -headWidth})
+	pa.Close()
+	c.Fill(pa)
 }
 
 // DataRange implements the DataRange method
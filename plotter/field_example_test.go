@@ -117,6 +117,43 @@ func ExampleField_colors() {
 	}
 }
 
+func ExampleField_quiverKey() {
+	f := plotter.NewField(field{
+		r: 9, c: 9,
+		fn: func(x, y float64) plotter.XY {
+			return plotter.XY{
+				X: -0.75*x + y,
+				Y: -0.75*y - x,
+			}
+		},
+	})
+	// Draw a vector of magnitude 8 with a length of one data unit.
+	f.Scale = 8
+	f.LineStyle.Width = 0.2
+
+	cmap := moreland.ExtendedBlackBody()
+	cmap.SetMin(0)
+	cmap.SetMax(1.2) // Use 1.2 to make highest magnitude vectors visible on white.
+	f.ColorMap = cmap
+
+	key, err := plotter.NewQuiverKey(f, 8, "8 m/s")
+	if err != nil {
+		log.Panic(err)
+	}
+
+	p := plot.New()
+	p.Title.Text = "Vector field"
+	p.X.Tick.Marker = integerTicks{}
+	p.Y.Tick.Marker = integerTicks{}
+
+	p.Add(f, key)
+
+	err = p.Save(250, 250, "testdata/quiver_key.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
 func ExampleField_gophers() {
 	file, err := os.Open("testdata/gopher_running.png")
 	if err != nil {
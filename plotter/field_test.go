@@ -5,12 +5,15 @@
 package plotter_test
 
 import (
+	"math"
 	"testing"
 
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
 	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 	"gonum.org/v1/plot/vg/vgimg"
 )
 
@@ -42,6 +45,10 @@ func TestFieldColors(t *testing.T) {
 	cmpimg.CheckPlot(ExampleField_colors, t, "color_field.png")
 }
 
+func TestFieldQuiverKey(t *testing.T) {
+	cmpimg.CheckPlot(ExampleField_quiverKey, t, "quiver_key.png")
+}
+
 func TestFieldGophers(t *testing.T) {
 	cmpimg.CheckPlot(ExampleField_gophers, t, "gopher_field.png")
 }
@@ -83,3 +90,86 @@ func TestFieldDims(t *testing.T) {
 		}()
 	}
 }
+
+func TestQuiverKeyZeroScale(t *testing.T) {
+	f := plotter.NewField(field{
+		r: 2, c: 2,
+		fn: func(x, y float64) plotter.XY {
+			return plotter.XY{X: y, Y: -x}
+		},
+	})
+
+	_, err := plotter.NewQuiverKey(f, 1, "1 m/s")
+	if err == nil {
+		t.Error("expected error for a quiver key of a field with a zero scale")
+	}
+
+	// A key of a field whose scale was reset is drawn without its vector.
+	f.Scale = 1
+	key, err := plotter.NewQuiverKey(f, 1, "1 m/s")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	f.Scale = 0
+
+	p := plot.New()
+	p.Add(f, key)
+	p.Draw(draw.New(vgimg.New(250, 175)))
+	if got := len(key.GlyphBoxes(p)); got != 1 {
+		t.Errorf("unexpected number of glyph boxes of a key without vector: got:%d want:1", got)
+	}
+}
+
+func TestQuiverKeyScale(t *testing.T) {
+	f := plotter.NewField(field{
+		r: 2, c: 2,
+		fn: func(x, y float64) plotter.XY {
+			return plotter.XY{X: 1}
+		},
+	})
+	f.Scale = 2
+	key, err := plotter.NewQuiverKey(f, 1, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	key.X, key.Y = 0.5, 0.5
+
+	p := plot.New()
+	p.Add(f, key)
+
+	// The grid cells of the field are not square on
+	// the canvas, but the reference vector must have
+	// the same shape as the vectors of the field.
+	rec := new(recorder.Canvas)
+	p.Draw(draw.NewCanvas(rec, vg.Points(300), vg.Points(150)))
+
+	var scales []*recorder.Scale
+	for _, a := range rec.Actions {
+		if s, ok := a.(*recorder.Scale); ok {
+			scales = append(scales, s)
+		}
+	}
+	if len(scales) != 5 {
+		t.Fatalf("unexpected number of scalings: got:%d want:5", len(scales))
+	}
+	want := *scales[0]
+	if want.X == want.Y {
+		t.Fatalf("unexpected square cell scaling: %v", want.Call())
+	}
+	for i, s := range scales[1:] {
+		if s.X != want.X || s.Y != want.Y {
+			t.Errorf("unexpected scaling %d: got:(%v, %v) want:(%v, %v)", i+1, s.X, s.Y, want.X, want.Y)
+		}
+	}
+
+	// The glyph boxes of the key cover its vector, half
+	// a data unit long, drawn in a data area two units wide.
+	var min, max float64 = 1, 0
+	for _, b := range key.GlyphBoxes(p) {
+		min = math.Min(min, b.X)
+		max = math.Max(max, b.X)
+	}
+	if got, want := max-min, 0.25; math.Abs(got-want) > 1e-12 {
+		t.Errorf("unexpected normalized width of the glyph boxes of the key: got:%v want:%v", got, want)
+	}
+}
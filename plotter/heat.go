@@ -59,6 +59,11 @@ type HeatMap struct {
 	// heat map.
 	Min, Max float64
 
+	// Norm is the normalization of the values within
+	// the dynamic range to the colors of the palette.
+	// If Norm is nil, values are mapped linearly.
+	Norm palette.Normalizer
+
 	// Rasterized indicates whether the heatmap
 	// should be produced using raster-based drawing.
 	Rasterized bool
@@ -119,21 +124,9 @@ func (h *HeatMap) plotRasterized(c draw.Canvas, plt *plot.Plot) {
 	})
 
 	pal := h.Palette.Colors()
-	ps := float64(len(pal)-1) / (h.Max - h.Min)
 	for i := range cols {
 		for j := range rows {
-			var col color.Color
-			switch v := h.GridXYZ.Z(i, j); {
-			case v < h.Min:
-				col = h.Underflow
-			case v > h.Max:
-				col = h.Overflow
-			case math.IsNaN(v), math.IsInf(ps, 0):
-				col = h.NaN
-			default:
-				col = pal[int((v-h.Min)*ps+0.5)] // Apply palette scaling.
-			}
-
+			col := h.color(pal, h.GridXYZ.Z(i, j))
 			if col != nil {
 				img.Set(i, rows-j-1, col)
 			}
@@ -154,8 +147,6 @@ func (h *HeatMap) plotVectorized(c draw.Canvas, plt *plot.Plot) {
 	if len(pal) == 0 {
 		panic("heatmap: empty palette")
 	}
-	// ps scales the palette uniformly across the data range.
-	ps := float64(len(pal)-1) / (h.Max - h.Min)
 
 	trX, trY := plt.Transforms(&c)
 
@@ -211,17 +202,7 @@ func (h *HeatMap) plotVectorized(c draw.Canvas, plt *plot.Plot) {
 			pa.Line(vg.Point{X: x, Y: dy})
 			pa.Close()
 
-			var col color.Color
-			switch v := h.GridXYZ.Z(i, j); {
-			case v < h.Min:
-				col = h.Underflow
-			case v > h.Max:
-				col = h.Overflow
-			case math.IsNaN(v), math.IsInf(ps, 0):
-				col = h.NaN
-			default:
-				col = pal[int((v-h.Min)*ps+0.5)] // Apply palette scaling.
-			}
+			col := h.color(pal, h.GridXYZ.Z(i, j))
 			if col != nil {
 				c.SetColor(col)
 				c.Fill(pa)
@@ -230,6 +211,88 @@ func (h *HeatMap) plotVectorized(c draw.Canvas, plt *plot.Plot) {
 	}
 }
 
+// norm returns the normalization of the heat map.
+func (h *HeatMap) norm() palette.Normalizer {
+	if h.Norm == nil {
+		return palette.LinearNorm{}
+	}
+	return h.Norm
+}
+
+// color returns the color of the value v using the palette
+// colors pal, or nil if v has no color.
+func (h *HeatMap) color(pal []color.Color, v float64) color.Color {
+	switch x := h.norm().Normalize(h.Min, h.Max, v); {
+	case x < 0:
+		return h.Underflow
+	case x > 1:
+		return h.Overflow
+	case math.IsNaN(x):
+		return h.NaN
+	default:
+		return pal[int(x*float64(len(pal)-1)+0.5)] // Apply palette scaling.
+	}
+}
+
+// ColorBar returns a color bar showing the discrete colors of
+// the palette of the heat map over its dynamic range, with
+// extensions for the Underflow and Overflow colors. The colors
+// and the ticks of the color bar are placed according to the
+// Norm of the heat map.
+func (h *HeatMap) ColorBar() *plot.ColorBar {
+	cb := plot.NewColorBar(nil)
+	cb.Norm = h.Norm
+	cb.Underflow = h.Underflow
+	cb.Overflow = h.Overflow
+
+	pal := h.Palette.Colors()
+	norm := h.norm()
+	if bn, ok := h.Norm.(palette.BoundaryNorm); ok {
+		if len(bn.Boundaries) >= 2 {
+			// Each interval between boundaries
+			// has a single color.
+			cb.Colors = make([]color.Color, len(bn.Boundaries)-1)
+			for i := range cb.Colors {
+				cb.Colors[i] = h.color(pal, (bn.Boundaries[i]+bn.Boundaries[i+1])/2)
+			}
+			return cb
+		}
+		// Without intervals, place the colors linearly.
+		cb.Norm = nil
+		norm = palette.LinearNorm{}
+	}
+
+	cb.Colors = pal
+	n := len(cb.Colors)
+	cb.Boundaries = make([]float64, n+1)
+	cb.Boundaries[0] = h.Min
+	for i := 1; i < n; i++ {
+		// Palette colors are centered on uniformly
+		// spaced normalized values across the data range.
+		cb.Boundaries[i] = invertNorm(norm, h.Min, h.Max, (float64(i)-0.5)/float64(n-1))
+	}
+	cb.Boundaries[n] = h.Max
+	return cb
+}
+
+// invertNorm returns the value between min and max
+// normalized by norm to the position x.
+func invertNorm(norm palette.Normalizer, min, max, x float64) float64 {
+	if _, ok := norm.(palette.LinearNorm); ok {
+		return min + x*(max-min)
+	}
+	lo, hi := min, max
+	for range 64 {
+		mid := (lo + hi) / 2
+		if norm.Normalize(min, max, mid) < x {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
 // DataRange implements the DataRange method
 // of the plot.DataRanger interface.
 func (h *HeatMap) DataRange() (xmin, xmax, ymin, ymax float64) {
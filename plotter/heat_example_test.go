@@ -6,12 +6,15 @@ package plotter_test
 
 import (
 	"fmt"
+	"image/color"
 	"log"
+	"math"
 	"os"
 
 	"gonum.org/v1/gonum/mat"
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/palette/perceptual"
 	"gonum.org/v1/plot/plotter"
 	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
@@ -83,3 +86,95 @@ func ExampleHeatMap() {
 		log.Panic(err)
 	}
 }
+
+// ExampleHeatMap_colorBar draws a heat map with a color bar
+// showing the colors of its palette, with extensions for the
+// values out of its dynamic range.
+func ExampleHeatMap_colorBar() {
+	m := offsetUnitGrid{
+		XOffset: -2,
+		YOffset: -1,
+		Data: mat.NewDense(3, 4, []float64{
+			1, 2, 3, 4,
+			5, 6, 7, 8,
+			9, 10, 11, 12,
+		})}
+	pal := palette.Heat(8, 1)
+	h := plotter.NewHeatMap(m, pal)
+	h.Min, h.Max = 2.5, 10.5
+	h.Underflow = color.Gray{Y: 64}
+	h.Overflow = color.Gray{Y: 192}
+
+	p := plot.New()
+	p.Title.Text = "Heat map"
+	p.X.Tick.Marker = integerTicks{}
+	p.Y.Tick.Marker = integerTicks{}
+	p.X.Padding = 0
+	p.Y.Padding = 0
+	p.Add(h)
+
+	p.ColorBar = h.ColorBar()
+	p.ColorBar.Axis.Label.Text = "Value"
+
+	err := p.Save(250, 175, "testdata/heatMap_colorBar.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+// ExampleHeatMap_norm draws heat maps of values spanning several
+// orders of magnitude, with their colors and color bars normalized
+// logarithmically on the left, and by discrete boundaries on the
+// right.
+func ExampleHeatMap_norm() {
+	data := mat.NewDense(10, 10, nil)
+	for i := range 10 {
+		for j := range 10 {
+			data.Set(i, j, math.Pow(10, float64(i+j)/6))
+		}
+	}
+	m := offsetUnitGrid{Data: data}
+
+	c := vgimg.New(vg.Points(500), vg.Points(225))
+	dc := draw.New(c)
+	tiles := draw.Tiles{Rows: 1, Cols: 2}
+
+	for i, test := range []struct {
+		norm palette.Normalizer
+		pal  palette.Palette
+	}{
+		{
+			norm: palette.LogNorm{},
+			pal:  perceptual.Viridis().Palette(64),
+		},
+		{
+			// A palette with one color per interval.
+			norm: palette.BoundaryNorm{Boundaries: []float64{1, 2, 5, 10, 100, 1000}},
+			pal:  perceptual.Viridis().Palette(5),
+		},
+	} {
+		h := plotter.NewHeatMap(m, test.pal)
+		h.Norm = test.norm
+
+		p := plot.New()
+		p.Title.Text = fmt.Sprintf("%T", test.norm)
+		p.X.Padding = 0
+		p.Y.Padding = 0
+		p.Add(h)
+
+		p.ColorBar = h.ColorBar()
+		p.ColorBar.Axis.Label.Text = "Value"
+		p.Draw(tiles.At(dc, i, 0))
+	}
+
+	w, err := os.Create("testdata/heatMap_norm.png")
+	if err != nil {
+		log.Panic(err)
+	}
+	defer w.Close()
+
+	png := vgimg.PngCanvas{Canvas: c}
+	if _, err := png.WriteTo(w); err != nil {
+		log.Panic(err)
+	}
+}
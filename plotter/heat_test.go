@@ -9,6 +9,7 @@ import (
 	"math"
 	"testing"
 
+	"gonum.org/v1/gonum/floats"
 	"gonum.org/v1/gonum/mat"
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
@@ -88,6 +89,31 @@ func TestHeatMapDims(t *testing.T) {
 	}
 }
 
+func TestHeatMapColorBar(t *testing.T) {
+	cmpimg.CheckPlot(ExampleHeatMap_colorBar, t, "heatMap_colorBar.png")
+}
+
+func TestHeatMapColorBarFewBoundaries(t *testing.T) {
+	m := offsetUnitGrid{Data: mat.NewDense(1, 3, []float64{0, 1, 2})}
+	for _, b := range [][]float64{nil, {1}} {
+		h := plotter.NewHeatMap(m, palette.Heat(3, 1))
+		h.Norm = palette.BoundaryNorm{Boundaries: b}
+
+		cb := h.ColorBar()
+		if cb.Norm != nil {
+			t.Errorf("unexpected color bar norm for boundaries %v: %#v", b, cb.Norm)
+		}
+		want := []float64{0, 0.5, 1.5, 2}
+		if !floats.Equal(cb.Boundaries, want) {
+			t.Errorf("unexpected color bar boundaries for boundaries %v: got:%v want:%v", b, cb.Boundaries, want)
+		}
+	}
+}
+
+func TestHeatMapNorm(t *testing.T) {
+	cmpimg.CheckPlot(ExampleHeatMap_norm, t, "heatMap_norm.png")
+}
+
 func TestRasterHeatMap(t *testing.T) {
 	cmpimg.CheckPlot(ExampleHeatMap_rasterized, t, "rasterHeatMap.png")
 }
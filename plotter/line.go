@@ -45,6 +45,11 @@ type Line struct {
 	// FillColor is the color to fill the area below the plot.
 	// Use nil to disable the filling. This is the default.
 	FillColor color.Color
+
+	// Decimator, if not nil, reduces the number of points
+	// drawn to those distinguishable at the resolution of
+	// the canvas.
+	Decimator Decimator
 }
 
 // NewLine returns a Line that uses the default line style and
@@ -69,6 +74,13 @@ func (pts *Line) Plot(c draw.Canvas, plt *plot.Plot) {
 		ps[i].X = trX(p.X)
 		ps[i].Y = trY(p.Y)
 	}
+	if pts.Decimator != nil {
+		idx := pts.Decimator.Decimate(c, ps)
+		for i, j := range idx {
+			ps[i] = ps[j]
+		}
+		ps = ps[:len(idx)]
+	}
 
 	if pts.FillColor != nil && len(ps) > 0 {
 		minY := trY(plt.Y.Min)
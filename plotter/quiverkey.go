@@ -0,0 +1,159 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/text"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// QuiverKey implements the Plotter interface, drawing a
+// reference vector of a Field with a label, so that the
+// length of the vectors of the Field can be read.
+//
+// The Scale of the Field must not be zero, since the length
+// of the vectors then depends on the size of their grid cell.
+// The reference vector of a field with a zero Scale is not
+// drawn.
+type QuiverKey struct {
+	// Field is the field the key refers to.
+	Field *Field
+
+	// Magnitude is the magnitude of the reference vector.
+	Magnitude float64
+
+	// X and Y are the location of the center of the
+	// reference vector, in the normalized coordinates
+	// of the data area of the plot.
+	X, Y float64
+
+	// Label is the text drawn above the reference vector.
+	Label string
+
+	// TextStyle is the style of the label.
+	TextStyle text.Style
+
+	// Padding is the distance between the reference
+	// vector and the label.
+	Padding vg.Length
+
+	// Color is the color of the reference vector.
+	// If Color is nil, the color of the LineStyle of
+	// the Field is used.
+	Color color.Color
+}
+
+// NewQuiverKey returns a QuiverKey for the field f, drawing
+// a reference vector of magnitude mag with the given label
+// in the top right corner of the data area. It returns an
+// error if the Scale of f is zero.
+func NewQuiverKey(f *Field, mag float64, label string) (*QuiverKey, error) {
+	if f.Scale == 0 {
+		return nil, errors.New("plotter: quiver key of a field with a zero scale")
+	}
+	return &QuiverKey{
+		Field:     f,
+		Magnitude: mag,
+		X:         0.9,
+		Y:         0.95,
+		Label:     label,
+		TextStyle: text.Style{
+			Font:    font.From(DefaultFont, DefaultFontSize),
+			XAlign:  draw.XCenter,
+			YAlign:  draw.YBottom,
+			Handler: plot.DefaultTextHandler,
+		},
+		Padding: vg.Points(2),
+	}, nil
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+func (k *QuiverKey) Plot(c draw.Canvas, plt *plot.Plot) {
+	f := k.Field
+	pt := vg.Point{X: c.X(k.X), Y: c.Y(k.Y)}
+	if f.Scale != 0 {
+		trX, trY := plt.Transforms(&c)
+
+		// Scale the reference vector as the vectors
+		// in the first grid cell of the field, so that
+		// their arrow heads have the same shape.
+		v := XY{X: k.Magnitude}
+		x0, y0 := f.FieldXY.X(0), f.FieldXY.Y(0)
+		left, right, down, up := f.cell(0, 0)
+		w := trX(x0+right) - trX(x0+left)
+		h := trY(y0+up) - trY(y0+down)
+		_, sx, sy := f.transform(trX, trY, x0, y0, w, h, v)
+
+		sty := f.LineStyle
+		if k.Color != nil {
+			sty.Color = k.Color
+		}
+
+		c.Push()
+		c.SetLineStyle(sty)
+		c.Translate(vg.Point{X: pt.X - vg.Length(sx)/2, Y: pt.Y})
+		if sx != 0 {
+			c.Scale(sx, sy)
+		}
+		v.X /= f.max
+		if f.DrawGlyph == nil {
+			drawVector(c, v)
+		} else {
+			f.DrawGlyph(c, sty, v)
+		}
+		c.Pop()
+	}
+
+	if k.Label != "" {
+		c.FillText(k.TextStyle, pt.Add(vg.Point{Y: k.Padding}), k.Label)
+	}
+}
+
+// GlyphBoxes implements the GlyphBoxes method
+// of the plot.GlyphBoxer interface.
+func (k *QuiverKey) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	var boxes []plot.GlyphBox
+	if f := k.Field; f.Scale != 0 {
+		// The length of the reference vector and the width
+		// of its arrow head, scaled as the vectors of the
+		// first grid cell, in normalized coordinates.
+		x0, y0 := f.FieldXY.X(0), f.FieldXY.Y(0)
+		left, right, down, up := f.cell(0, 0)
+		l := plt.X.Norm(x0+k.Magnitude/f.Scale) - plt.X.Norm(x0)
+		w := plt.X.Norm(x0+right) - plt.X.Norm(x0+left)
+		h := plt.Y.Norm(y0+up) - plt.Y.Norm(y0+down)
+		head := 0.0
+		if w != 0 {
+			head = 0.1 * math.Abs(l*h/w)
+		}
+		lw := f.LineStyle.Width / 2
+		r := vg.Rectangle{
+			Min: vg.Point{X: -lw, Y: -lw},
+			Max: vg.Point{X: lw, Y: lw},
+		}
+		for _, pt := range [][2]float64{
+			{k.X - l/2, k.Y},
+			{k.X + l/2, k.Y - head},
+			{k.X + l/2, k.Y + head},
+		} {
+			boxes = append(boxes, plot.GlyphBox{X: pt[0], Y: pt[1], Rectangle: r})
+		}
+	}
+	if k.Label != "" {
+		boxes = append(boxes, plot.GlyphBox{
+			X:         k.X,
+			Y:         k.Y,
+			Rectangle: k.TextStyle.Rectangle(k.Label).Add(vg.Point{Y: k.Padding}),
+		})
+	}
+	return boxes
+}
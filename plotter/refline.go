@@ -0,0 +1,410 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"math"
+	"slices"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/text"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Reference lines and spans always extend across the whole data
+// area of a plot. They do not implement the plot.DataRanger
+// interface, so they do not change the range of the axes.
+
+// HLine implements the Plotter interface, drawing a horizontal
+// reference line across the data area of a plot.
+type HLine struct {
+	// Y is the data coordinate of the line.
+	Y float64
+
+	// LineStyle is the style of the line.
+	draw.LineStyle
+
+	// Label is the text drawn along the line.
+	// If Label is empty, no label is drawn.
+	Label string
+
+	// TextStyle is the style of the label.
+	TextStyle text.Style
+
+	// Position is the location of the label along the line,
+	// in the normalized coordinates of the data area.
+	Position float64
+}
+
+// NewHLine returns a horizontal reference line at y, using the
+// default line style, with its label drawn above the right end
+// of the line.
+func NewHLine(y float64) *HLine {
+	sty := refLabelStyle()
+	sty.XAlign = draw.XRight
+	sty.YAlign = draw.YBottom
+	return &HLine{
+		Y:         y,
+		LineStyle: DefaultLineStyle,
+		TextStyle: sty,
+		Position:  1,
+	}
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+func (l *HLine) Plot(c draw.Canvas, plt *plot.Plot) {
+	_, trY := plt.Transforms(&c)
+	y := trY(l.Y)
+	if !c.ContainsY(y) {
+		return
+	}
+	c.StrokeLine2(l.LineStyle, c.Min.X, y, c.Max.X, y)
+	if l.Label != "" {
+		c.FillText(l.TextStyle, vg.Point{X: c.X(l.Position), Y: y + l.Width/2}, l.Label)
+	}
+}
+
+// Thumbnail implements the Thumbnail method
+// of the plot.Thumbnailer interface.
+func (l *HLine) Thumbnail(c *draw.Canvas) {
+	y := c.Center().Y
+	c.StrokeLine2(l.LineStyle, c.Min.X, y, c.Max.X, y)
+}
+
+// VLine implements the Plotter interface, drawing a vertical
+// reference line across the data area of a plot.
+type VLine struct {
+	// X is the data coordinate of the line.
+	X float64
+
+	// LineStyle is the style of the line.
+	draw.LineStyle
+
+	// Label is the text drawn along the line.
+	// If Label is empty, no label is drawn.
+	Label string
+
+	// TextStyle is the style of the label.
+	TextStyle text.Style
+
+	// Position is the location of the label along the line,
+	// in the normalized coordinates of the data area.
+	Position float64
+}
+
+// NewVLine returns a vertical reference line at x, using the
+// default line style, with its label drawn along the top end
+// of the line.
+func NewVLine(x float64) *VLine {
+	sty := refLabelStyle()
+	sty.Rotation = math.Pi / 2
+	sty.XAlign = draw.XRight
+	sty.YAlign = draw.YBottom
+	return &VLine{
+		X:         x,
+		LineStyle: DefaultLineStyle,
+		TextStyle: sty,
+		Position:  1,
+	}
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+func (l *VLine) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, _ := plt.Transforms(&c)
+	x := trX(l.X)
+	if !c.ContainsX(x) {
+		return
+	}
+	c.StrokeLine2(l.LineStyle, x, c.Min.Y, x, c.Max.Y)
+	if l.Label != "" {
+		c.FillText(l.TextStyle, vg.Point{X: x - l.Width/2, Y: c.Y(l.Position)}, l.Label)
+	}
+}
+
+// Thumbnail implements the Thumbnail method
+// of the plot.Thumbnailer interface.
+func (l *VLine) Thumbnail(c *draw.Canvas) {
+	x := c.Center().X
+	c.StrokeLine2(l.LineStyle, x, c.Min.Y, x, c.Max.Y)
+}
+
+// ABLine implements the Plotter interface, drawing the
+// reference line y = A + B·x across the data area of a plot.
+type ABLine struct {
+	// A and B are the intercept and
+	// slope of the line.
+	A, B float64
+
+	// LineStyle is the style of the line.
+	draw.LineStyle
+
+	// Samples is the number of samples used
+	// to draw the line when an axis of the plot
+	// is not linear.
+	Samples int
+
+	// Label is the text drawn along the line.
+	// If Label is empty, no label is drawn.
+	Label string
+
+	// TextStyle is the style of the label.
+	// Its rotation is set to follow the line.
+	TextStyle text.Style
+
+	// Position is the location of the label along the
+	// line, in the normalized X coordinate of the data area.
+	Position float64
+}
+
+// NewABLine returns the reference line y = a + b·x, using the
+// default line style, with its label drawn above the middle of
+// the line.
+func NewABLine(a, b float64) *ABLine {
+	sty := refLabelStyle()
+	sty.XAlign = draw.XCenter
+	sty.YAlign = draw.YBottom
+	return &ABLine{
+		A:         a,
+		B:         b,
+		LineStyle: DefaultLineStyle,
+		Samples:   50,
+		TextStyle: sty,
+		Position:  0.5,
+	}
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+func (l *ABLine) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+
+	n := 2
+	_, xLinear := plt.X.Scale.(plot.LinearScale)
+	_, yLinear := plt.Y.Scale.(plot.LinearScale)
+	if !xLinear || !yLinear {
+		n = max(l.Samples, 2)
+	}
+	min, max := plt.X.Min, plt.X.Max
+	line := make([]vg.Point, n)
+	for i := range line {
+		x := min + float64(i)*(max-min)/float64(n-1)
+		line[i] = vg.Point{X: trX(x), Y: trY(l.A + l.B*x)}
+	}
+	c.StrokeLines(l.LineStyle, c.ClipLinesXY(line)...)
+
+	if l.Label == "" {
+		return
+	}
+	// Locate the label by its position along the X axis,
+	// which is reversed on the canvas by decreasing scales.
+	if line[0].X > line[n-1].X {
+		slices.Reverse(line)
+	}
+	x := c.X(l.Position)
+	i := segment(line, x)
+	p0, p1 := line[i], line[i+1]
+	if p0.X == p1.X {
+		return
+	}
+	t := float64((x - p0.X) / (p1.X - p0.X))
+	pt := vg.Point{
+		X: x,
+		Y: p0.Y + vg.Length(t)*(p1.Y-p0.Y),
+	}
+	if !c.Contains(pt) {
+		return
+	}
+	sty := l.TextStyle
+	// The segment points right, so the label
+	// is never drawn upside down.
+	sty.Rotation = math.Atan2(float64(p1.Y-p0.Y), float64(p1.X-p0.X))
+	c.FillText(sty, pt, l.Label)
+}
+
+// segment returns the index i of the segment [line[i], line[i+1]]
+// of the line, ordered by increasing X, containing x.
+func segment(line []vg.Point, x vg.Length) int {
+	for i := range len(line) - 2 {
+		if x < line[i+1].X {
+			return i
+		}
+	}
+	return len(line) - 2
+}
+
+// Thumbnail implements the Thumbnail method
+// of the plot.Thumbnailer interface.
+func (l *ABLine) Thumbnail(c *draw.Canvas) {
+	y := c.Center().Y
+	c.StrokeLine2(l.LineStyle, c.Min.X, y, c.Max.X, y)
+}
+
+// HSpan implements the Plotter interface, drawing a horizontal
+// band between two Y values across the data area of a plot.
+type HSpan struct {
+	// Min and Max are the data coordinates
+	// of the bottom and top of the band.
+	Min, Max float64
+
+	// FillColor is the color of the band.
+	FillColor color.Color
+
+	// LineStyle is the style of the edges of the band.
+	// Use zero width to disable the edges.
+	draw.LineStyle
+
+	// Label is the text drawn inside the band.
+	// If Label is empty, no label is drawn.
+	Label string
+
+	// TextStyle is the style of the label.
+	TextStyle text.Style
+
+	// Position is the horizontal location of the label,
+	// in the normalized coordinates of the data area.
+	// The label is drawn at the top of the band.
+	Position float64
+}
+
+// NewHSpan returns a horizontal band between min and max, filled
+// with a light gray, with its label drawn in its top left corner.
+func NewHSpan(min, max float64) *HSpan {
+	sty := refLabelStyle()
+	sty.XAlign = draw.XLeft
+	sty.YAlign = draw.YTop
+	return &HSpan{
+		Min:       min,
+		Max:       max,
+		FillColor: color.Gray{Y: 220},
+		TextStyle: sty,
+	}
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+func (s *HSpan) Plot(c draw.Canvas, plt *plot.Plot) {
+	_, trY := plt.Transforms(&c)
+	y0, y1 := trY(s.Min), trY(s.Max)
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+	y0, y1 = max(y0, c.Min.Y), min(y1, c.Max.Y)
+	if y0 >= y1 {
+		return
+	}
+	r := vg.Rectangle{
+		Min: vg.Point{X: c.Min.X, Y: y0},
+		Max: vg.Point{X: c.Max.X, Y: y1},
+	}
+	drawSpan(c, r, s.FillColor, s.LineStyle, false)
+	if s.Label != "" {
+		c.FillText(s.TextStyle, vg.Point{X: c.X(s.Position), Y: y1}, s.Label)
+	}
+}
+
+// Thumbnail implements the Thumbnail method
+// of the plot.Thumbnailer interface.
+func (s *HSpan) Thumbnail(c *draw.Canvas) {
+	drawSpan(*c, c.Rectangle, s.FillColor, s.LineStyle, false)
+}
+
+// VSpan implements the Plotter interface, drawing a vertical
+// band between two X values across the data area of a plot.
+type VSpan struct {
+	// Min and Max are the data coordinates
+	// of the left and right of the band.
+	Min, Max float64
+
+	// FillColor is the color of the band.
+	FillColor color.Color
+
+	// LineStyle is the style of the edges of the band.
+	// Use zero width to disable the edges.
+	draw.LineStyle
+
+	// Label is the text drawn inside the band.
+	// If Label is empty, no label is drawn.
+	Label string
+
+	// TextStyle is the style of the label.
+	TextStyle text.Style
+
+	// Position is the vertical location of the label,
+	// in the normalized coordinates of the data area.
+	// The label is drawn at the center of the band.
+	Position float64
+}
+
+// NewVSpan returns a vertical band between min and max, filled
+// with a light gray, with its label drawn at its top.
+func NewVSpan(min, max float64) *VSpan {
+	sty := refLabelStyle()
+	sty.XAlign = draw.XCenter
+	sty.YAlign = draw.YTop
+	return &VSpan{
+		Min:       min,
+		Max:       max,
+		FillColor: color.Gray{Y: 220},
+		TextStyle: sty,
+		Position:  1,
+	}
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+func (s *VSpan) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, _ := plt.Transforms(&c)
+	x0, x1 := trX(s.Min), trX(s.Max)
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	x0, x1 = max(x0, c.Min.X), min(x1, c.Max.X)
+	if x0 >= x1 {
+		return
+	}
+	r := vg.Rectangle{
+		Min: vg.Point{X: x0, Y: c.Min.Y},
+		Max: vg.Point{X: x1, Y: c.Max.Y},
+	}
+	drawSpan(c, r, s.FillColor, s.LineStyle, true)
+	if s.Label != "" {
+		c.FillText(s.TextStyle, vg.Point{X: (x0 + x1) / 2, Y: c.Y(s.Position)}, s.Label)
+	}
+}
+
+// Thumbnail implements the Thumbnail method
+// of the plot.Thumbnailer interface.
+func (s *VSpan) Thumbnail(c *draw.Canvas) {
+	drawSpan(*c, c.Rectangle, s.FillColor, s.LineStyle, true)
+}
+
+// drawSpan fills the rectangle r and strokes its vertical
+// edges if vertical is true, its horizontal edges otherwise.
+func drawSpan(c draw.Canvas, r vg.Rectangle, fill color.Color, sty draw.LineStyle, vertical bool) {
+	if fill != nil {
+		c.SetColor(fill)
+		c.Fill(r.Path())
+	}
+	if sty.Width <= 0 {
+		return
+	}
+	if vertical {
+		c.StrokeLine2(sty, r.Min.X, r.Min.Y, r.Min.X, r.Max.Y)
+		c.StrokeLine2(sty, r.Max.X, r.Min.Y, r.Max.X, r.Max.Y)
+		return
+	}
+	c.StrokeLine2(sty, r.Min.X, r.Min.Y, r.Max.X, r.Min.Y)
+	c.StrokeLine2(sty, r.Min.X, r.Max.Y, r.Max.X, r.Max.Y)
+}
+
+// refLabelStyle returns the default text style of the
+// labels of reference lines and spans.
+func refLabelStyle() text.Style {
+	return text.Style{
+		Color:   color.Black,
+		Font:    font.From(DefaultFont, DefaultFontSize),
+		Handler: plot.DefaultTextHandler,
+	}
+}
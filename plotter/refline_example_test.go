@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+	"math/rand/v2"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExampleHLine draws reference lines and spans on top
+// of a scatter plot. Reference lines and spans do not
+// change the range of the axes.
+func ExampleHLine() {
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	pts := make(plotter.XYs, 50)
+	for i := range pts {
+		pts[i].X = rnd.Float64() * 10
+		pts[i].Y = 1 + 0.5*pts[i].X + rnd.NormFloat64()
+	}
+
+	p := plot.New()
+	p.Title.Text = "Reference lines"
+	p.X.Label.Text = "X"
+	p.Y.Label.Text = "Y"
+
+	span := plotter.NewVSpan(4, 6)
+	span.FillColor = color.RGBA{R: 255, G: 230, B: 200, A: 255}
+	span.Label = "window"
+
+	band := plotter.NewHSpan(2, 3)
+	band.FillColor = color.RGBA{R: 200, G: 230, B: 255, A: 255}
+
+	s, err := plotter.NewScatter(pts)
+	if err != nil {
+		log.Panic(err)
+	}
+
+	mean := plotter.NewHLine(3.5)
+	mean.Color = color.RGBA{R: 255, A: 255}
+	mean.Dashes = []vg.Length{vg.Points(4), vg.Points(2)}
+	mean.Label = "mean"
+
+	limit := plotter.NewVLine(8)
+	limit.Color = color.RGBA{B: 255, A: 255}
+	limit.Label = "limit"
+
+	fit := plotter.NewABLine(1, 0.5)
+	fit.Color = color.RGBA{G: 128, A: 255}
+	fit.Label = "y = 1 + x/2"
+
+	// Far away from the data: it does not extend the axes.
+	off := plotter.NewHLine(100)
+
+	p.Add(span, band, s, mean, limit, fit, off)
+	p.Legend.Add("band", band)
+	p.Legend.Add("mean", mean)
+	p.Legend.Add("fit", fit)
+
+	err = p.Save(250, 200, "testdata/refline.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
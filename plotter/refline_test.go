@@ -0,0 +1,130 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestHLine(t *testing.T) {
+	cmpimg.CheckPlot(ExampleHLine, t, "refline.png")
+}
+
+func TestRefLineDataRange(t *testing.T) {
+	for _, p := range []plot.Plotter{
+		plotter.NewHLine(10),
+		plotter.NewVLine(10),
+		plotter.NewABLine(10, 1),
+		plotter.NewHSpan(10, 20),
+		plotter.NewVSpan(10, 20),
+	} {
+		if _, ok := p.(plot.DataRanger); ok {
+			t.Errorf("%T implements plot.DataRanger", p)
+		}
+	}
+}
+
+func TestABLineLabel(t *testing.T) {
+	const size = 200
+	for _, test := range []struct {
+		name   string
+		x, y   plot.Normalizer
+		min    float64
+		max    float64
+		anchor vg.Point
+		rot    float64
+	}{
+		{name: "linear", x: plot.LinearScale{}, y: plot.LinearScale{}, min: 0, max: 10, anchor: vg.Point{X: 50, Y: 50}, rot: math.Pi / 4},
+		{name: "log", x: plot.LogScale{}, y: plot.LogScale{}, min: 1, max: 100, anchor: vg.Point{X: 50, Y: 50}, rot: math.Pi / 4},
+		{name: "inverted", x: plot.InvertedScale{Normalizer: plot.LinearScale{}}, y: plot.LinearScale{}, min: 0, max: 10, anchor: vg.Point{X: 50, Y: 150}, rot: -math.Pi / 4},
+		{name: "inverted_log", x: plot.InvertedScale{Normalizer: plot.LogScale{}}, y: plot.LogScale{}, min: 1, max: 100, anchor: vg.Point{X: 50, Y: 150}, rot: -math.Pi / 4},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			p := plot.New()
+			p.X.Scale, p.Y.Scale = test.x, test.y
+			p.X.Min, p.X.Max = test.min, test.max
+			p.Y.Min, p.Y.Max = test.min, test.max
+
+			l := plotter.NewABLine(0, 1)
+			l.Label = "y"
+			l.Position = 0.25
+			l.TextStyle.XAlign = draw.XLeft
+
+			rec := new(recorder.Canvas)
+			l.Plot(draw.NewCanvas(rec, size, size), p)
+
+			var (
+				rot  float64
+				text *recorder.FillString
+			)
+			for _, a := range rec.Actions {
+				switch a := a.(type) {
+				case *recorder.Rotate:
+					rot = a.Angle
+				case *recorder.FillString:
+					text = a
+				}
+			}
+			if text == nil {
+				t.Fatal("no label drawn")
+			}
+			if math.Abs(rot-test.rot) > 1e-9 {
+				t.Errorf("unexpected label rotation: got:%v want:%v", rot, test.rot)
+			}
+
+			// The label is drawn in the rotated frame, within
+			// a font descent above its anchor on the line.
+			sin, cos := math.Sincos(rot)
+			got := vg.Point{
+				X: text.Point.X*vg.Length(cos) - text.Point.Y*vg.Length(sin),
+				Y: text.Point.X*vg.Length(sin) + text.Point.Y*vg.Length(cos),
+			}
+			if d := got.Sub(test.anchor); math.Hypot(float64(d.X), float64(d.Y)) > 2 {
+				t.Errorf("unexpected label location: got:%v want:%v", got, test.anchor)
+			}
+		})
+	}
+}
+
+func TestRefLineAutoRange(t *testing.T) {
+	p := plot.New()
+	s, err := plotter.NewScatter(plotter.XYs{{X: 0, Y: 0}, {X: 1, Y: 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	hl := plotter.NewHLine(10)
+	hl.Label = "h"
+	vl := plotter.NewVLine(10)
+	vl.Label = "v"
+	hs := plotter.NewHSpan(5, 20)
+	hs.Label = "hs"
+	vs := plotter.NewVSpan(5, 20)
+	vs.Label = "vs"
+	p.Add(s, hl, vl, hs, vs, plotter.NewABLine(10, 1))
+
+	if got, want := [4]float64{p.X.Min, p.X.Max, p.Y.Min, p.Y.Max}, [4]float64{0, 1, 0, 1}; got != want {
+		t.Errorf("unexpected axis ranges: got:%v want:%v", got, want)
+	}
+
+	// The lines and spans outside the axis ranges are not drawn.
+	rec := new(recorder.Canvas)
+	p.Draw(draw.NewCanvas(rec, 200, 200))
+	for _, a := range rec.Actions {
+		if a, ok := a.(*recorder.FillString); ok {
+			switch a.String {
+			case "h", "v", "hs", "vs":
+				t.Errorf("unexpected label drawn outside the axis ranges: %q", a.String)
+			}
+		}
+	}
+}
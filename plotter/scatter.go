@@ -23,6 +23,11 @@ type Scatter struct {
 	// GlyphStyle is the style of the glyphs drawn
 	// at each point.
 	draw.GlyphStyle
+
+	// Decimator, if not nil, reduces the number of glyphs
+	// drawn to those distinguishable at the resolution of
+	// the canvas.
+	Decimator Decimator
 }
 
 // NewScatter returns a Scatter that uses the
@@ -46,8 +51,19 @@ func (pts *Scatter) Plot(c draw.Canvas, plt *plot.Plot) {
 	if pts.GlyphStyleFunc != nil {
 		glyph = pts.GlyphStyleFunc
 	}
+	if pts.Decimator == nil {
+		for i, p := range pts.XYs {
+			c.DrawGlyph(glyph(i), vg.Point{X: trX(p.X), Y: trY(p.Y)})
+		}
+		return
+	}
+
+	ps := make([]vg.Point, len(pts.XYs))
 	for i, p := range pts.XYs {
-		c.DrawGlyph(glyph(i), vg.Point{X: trX(p.X), Y: trY(p.Y)})
+		ps[i] = vg.Point{X: trX(p.X), Y: trY(p.Y)}
+	}
+	for _, i := range pts.Decimator.Decimate(c, ps) {
+		c.DrawGlyph(glyph(i), ps[i])
 	}
 }
 
@@ -0,0 +1,415 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"math"
+	"slices"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Streamplot implements the Plotter interface, drawing
+// the streamlines of the vector field of a FieldXY.
+//
+// Streamlines are integrated with a fourth order Runge-Kutta
+// scheme on the bilinear interpolation of the field between
+// grid nodes. The X and Y coordinates of the grid must be
+// strictly increasing.
+type Streamplot struct {
+	FieldXY FieldXY
+
+	// Density controls the closeness of streamlines.
+	// With a density of 1, the domain is divided in a
+	// 30×30 grid and each cell of the grid is traversed
+	// by at most one streamline.
+	Density float64
+
+	// LineStyle is the style of the streamlines.
+	LineStyle draw.LineStyle
+
+	// ColorMap, if not nil, is used to color the
+	// streamlines according to the magnitude of the
+	// field. The magnitude range of the field is
+	// rescaled to the range of the ColorMap.
+	ColorMap palette.ColorMap
+
+	// MaxWidth, if greater than the width of LineStyle,
+	// is the width of streamlines where the magnitude of
+	// the field is maximal. The width of streamlines then
+	// varies linearly with the magnitude of the field,
+	// from the width of LineStyle at the minimum magnitude.
+	MaxWidth vg.Length
+
+	// ArrowSize is the size of the arrow heads drawn along
+	// each streamline to show its direction. If ArrowSize
+	// is zero, no arrow is drawn.
+	ArrowSize vg.Length
+
+	// ArrowSpacing, if positive, is the distance along
+	// each streamline between its arrow heads, the first
+	// arrow head being drawn at ArrowSpacing from the start
+	// of the streamline. Streamlines shorter than ArrowSpacing,
+	// and all streamlines when ArrowSpacing is zero, have a
+	// single arrow head drawn at their middle.
+	ArrowSpacing vg.Length
+
+	// min and max define the magnitude range of the field.
+	min, max float64
+}
+
+// NewStreamplot creates a new streamline plotter for the vector field f.
+func NewStreamplot(f FieldXY) *Streamplot {
+	min, max := math.Inf(+1), math.Inf(-1)
+	c, r := f.Dims()
+	for i := range c {
+		for j := range r {
+			v := f.Vector(i, j)
+			d := math.Hypot(v.X, v.Y)
+			if math.IsNaN(d) {
+				continue
+			}
+			min = math.Min(min, d)
+			max = math.Max(max, d)
+		}
+	}
+
+	return &Streamplot{
+		FieldXY:   f,
+		Density:   1,
+		LineStyle: DefaultLineStyle,
+		ArrowSize: vg.Points(4),
+		min:       min,
+		max:       max,
+	}
+}
+
+const (
+	// streamMinLength and streamMaxLength are the minimum
+	// and maximum lengths of a streamline, in units of
+	// the size of the domain.
+	streamMinLength = 0.1
+	streamMaxLength = 4
+)
+
+// streamPoint is a point of a streamline in the normalized
+// coordinates of the domain, with the field magnitude there.
+type streamPoint struct {
+	a, b float64
+	mag  float64
+}
+
+// streamMask records the cells of the domain already
+// traversed by a streamline.
+type streamMask struct {
+	n    int
+	used []bool
+
+	// cells holds the cells marked by the
+	// streamline being integrated.
+	cells []int
+}
+
+// cell returns the index of the mask cell at (a, b).
+func (m *streamMask) cell(a, b float64) int {
+	i := min(int(a*float64(m.n)), m.n-1)
+	j := min(int(b*float64(m.n)), m.n-1)
+	return j*m.n + i
+}
+
+// mark marks the cell k as used by the current streamline.
+func (m *streamMask) mark(k int) {
+	m.used[k] = true
+	m.cells = append(m.cells, k)
+}
+
+// undo clears the cells marked by the current streamline.
+func (m *streamMask) undo() {
+	for _, k := range m.cells {
+		m.used[k] = false
+	}
+	m.cells = m.cells[:0]
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+func (s *Streamplot) Plot(c draw.Canvas, plt *plot.Plot) {
+	cols, rows := s.FieldXY.Dims()
+	if cols < 2 || rows < 2 || !(s.max > 0) {
+		return
+	}
+	density := s.Density
+	if density <= 0 {
+		density = 1
+	}
+	n := max(int(30*density), 1)
+	mask := &streamMask{n: n, used: make([]bool, n*n)}
+
+	trX, trY := plt.Transforms(&c)
+	for _, k := range spiral(n) {
+		if mask.used[k] {
+			continue
+		}
+		a := (float64(k%n) + 0.5) / float64(n)
+		b := (float64(k/n) + 0.5) / float64(n)
+		line := s.streamline(a, b, mask)
+		if line == nil {
+			continue
+		}
+		pts := make([]vg.Point, len(line))
+		mags := make([]float64, len(line))
+		for i, p := range line {
+			x, y := s.data(p.a, p.b)
+			pts[i] = vg.Point{X: trX(x), Y: trY(y)}
+			mags[i] = p.mag
+		}
+		s.draw(c, pts, mags)
+	}
+}
+
+// streamline returns the streamline going through (a, b), or nil
+// if the streamline is too short.
+func (s *Streamplot) streamline(a, b float64, m *streamMask) []streamPoint {
+	m.cells = m.cells[:0]
+	m.mark(m.cell(a, b))
+	bwd, lb := s.integrate(a, b, -1, m)
+	fwd, lf := s.integrate(a, b, +1, m)
+	if lb+lf < streamMinLength {
+		m.undo()
+		return nil
+	}
+	slices.Reverse(bwd)
+	return append(bwd, fwd[1:]...)
+}
+
+// integrate integrates the streamline starting at (a, b) in the
+// given direction, until it leaves the domain, reaches a cell
+// already used by another streamline, a point where the field
+// vanishes, or the maximum length.
+func (s *Streamplot) integrate(a, b, dir float64, m *streamMask) ([]streamPoint, float64) {
+	h := dir * 0.25 / float64(m.n)
+	cur := m.cell(a, b)
+
+	da, db, mag, ok := s.velocity(a, b)
+	if !ok {
+		return []streamPoint{{a: a, b: b, mag: mag}}, 0
+	}
+	pts := []streamPoint{{a: a, b: b, mag: mag}}
+	var length float64
+	for length < streamMaxLength {
+		k1a, k1b := da, db
+		k2a, k2b, _, ok2 := s.velocity(a+0.5*h*k1a, b+0.5*h*k1b)
+		k3a, k3b, _, ok3 := s.velocity(a+0.5*h*k2a, b+0.5*h*k2b)
+		k4a, k4b, _, ok4 := s.velocity(a+h*k3a, b+h*k3b)
+		if !ok2 || !ok3 || !ok4 {
+			break
+		}
+		na := a + h/6*(k1a+2*k2a+2*k3a+k4a)
+		nb := b + h/6*(k1b+2*k2b+2*k3b+k4b)
+		if na < 0 || na > 1 || nb < 0 || nb > 1 {
+			break
+		}
+		if k := m.cell(na, nb); k != cur {
+			if m.used[k] {
+				break
+			}
+			m.mark(k)
+			cur = k
+		}
+
+		length += math.Hypot(na-a, nb-b)
+		a, b = na, nb
+		da, db, mag, ok = s.velocity(a, b)
+		pts = append(pts, streamPoint{a: a, b: b, mag: mag})
+		if !ok {
+			break
+		}
+	}
+	return pts, length
+}
+
+// velocity returns the unit direction of the field at (a, b),
+// in the normalized coordinates of the domain, and the magnitude
+// of the field there. ok is false if the direction is undefined.
+func (s *Streamplot) velocity(a, b float64) (da, db, mag float64, ok bool) {
+	if a < 0 || a > 1 || b < 0 || b > 1 {
+		return 0, 0, math.NaN(), false
+	}
+	cols, rows := s.FieldXY.Dims()
+	u := a * float64(cols-1)
+	v := b * float64(rows-1)
+	i := min(int(u), cols-2)
+	j := min(int(v), rows-2)
+	fu, fv := u-float64(i), v-float64(j)
+
+	v00 := s.FieldXY.Vector(i, j)
+	v10 := s.FieldXY.Vector(i+1, j)
+	v01 := s.FieldXY.Vector(i, j+1)
+	v11 := s.FieldXY.Vector(i+1, j+1)
+	vx := (1-fv)*((1-fu)*v00.X+fu*v10.X) + fv*((1-fu)*v01.X+fu*v11.X)
+	vy := (1-fv)*((1-fu)*v00.Y+fu*v10.Y) + fv*((1-fu)*v01.Y+fu*v11.Y)
+	mag = math.Hypot(vx, vy)
+
+	dx := (s.FieldXY.X(i+1) - s.FieldXY.X(i)) * float64(cols-1)
+	dy := (s.FieldXY.Y(j+1) - s.FieldXY.Y(j)) * float64(rows-1)
+	da, db = vx/dx, vy/dy
+	norm := math.Hypot(da, db)
+	if norm == 0 || math.IsNaN(norm) || math.IsInf(norm, 0) {
+		return 0, 0, mag, false
+	}
+	return da / norm, db / norm, mag, true
+}
+
+// data returns the data coordinates of the point (a, b)
+// given in the normalized coordinates of the domain.
+func (s *Streamplot) data(a, b float64) (x, y float64) {
+	cols, rows := s.FieldXY.Dims()
+	u := a * float64(cols-1)
+	v := b * float64(rows-1)
+	i := min(int(u), cols-2)
+	j := min(int(v), rows-2)
+	fu, fv := u-float64(i), v-float64(j)
+	x = (1-fu)*s.FieldXY.X(i) + fu*s.FieldXY.X(i+1)
+	y = (1-fv)*s.FieldXY.Y(j) + fv*s.FieldXY.Y(j+1)
+	return x, y
+}
+
+// style returns the line style for the field magnitude mag.
+func (s *Streamplot) style(mag float64) draw.LineStyle {
+	sty := s.LineStyle
+	t := 0.0
+	if s.max > s.min {
+		t = (mag - s.min) / (s.max - s.min)
+	}
+	if math.IsNaN(t) {
+		t = 0
+	}
+	t = math.Max(0, math.Min(1, t))
+	if s.ColorMap != nil {
+		col, err := palette.ColorAt(s.ColorMap, t)
+		if err == nil {
+			sty.Color = col
+		}
+	}
+	if s.MaxWidth > sty.Width {
+		sty.Width += vg.Length(t) * (s.MaxWidth - sty.Width)
+	}
+	return sty
+}
+
+// draw draws the streamline with the given canvas points
+// and field magnitudes.
+func (s *Streamplot) draw(c draw.Canvas, pts []vg.Point, mags []float64) {
+	if s.ColorMap == nil && s.MaxWidth <= s.LineStyle.Width {
+		c.StrokeLines(s.LineStyle, c.ClipLinesXY(pts)...)
+	} else {
+		for i := range len(pts) - 1 {
+			sty := s.style(0.5 * (mags[i] + mags[i+1]))
+			c.StrokeLines(sty, c.ClipLinesXY(pts[i:i+2])...)
+		}
+	}
+
+	if s.ArrowSize <= 0 || len(pts) < 2 {
+		return
+	}
+	if s.ArrowSpacing > 0 {
+		var (
+			n    int       // n is the number of arrows drawn.
+			arc  vg.Length // arc is the length up to pts[i].
+			next = s.ArrowSpacing
+		)
+		for i := range len(pts) - 1 {
+			tail, tip := pts[i], pts[i+1]
+			d := vg.Length(math.Hypot(float64(tip.X-tail.X), float64(tip.Y-tail.Y)))
+			for ; next <= arc+d && d > 0; next += s.ArrowSpacing {
+				f := (next - arc) / d
+				at := vg.Point{X: tail.X + f*(tip.X-tail.X), Y: tail.Y + f*(tip.Y-tail.Y)}
+				s.arrow(c, tail, at, mags[i])
+				n++
+			}
+			arc += d
+		}
+		if n != 0 {
+			return
+		}
+	}
+	i := len(pts) / 2
+	if i == len(pts)-1 {
+		i--
+	}
+	s.arrow(c, pts[i], pts[i+1], mags[i])
+}
+
+// arrow draws an arrow head centered on tip, pointing away from
+// tail, colored according to the field magnitude mag.
+func (s *Streamplot) arrow(c draw.Canvas, tail, tip vg.Point, mag float64) {
+	if !c.Contains(tip) {
+		return
+	}
+	dx, dy := tip.X-tail.X, tip.Y-tail.Y
+	d := vg.Length(math.Hypot(float64(dx), float64(dy)))
+	if d == 0 {
+		return
+	}
+	// Unit vectors along and across the streamline.
+	ux, uy := dx/d, dy/d
+	l, w := s.ArrowSize, s.ArrowSize/2
+	col := s.style(mag).Color
+	if col == nil {
+		col = color.Black
+	}
+	c.FillPolygon(col, []vg.Point{
+		{X: tip.X + ux*l/2, Y: tip.Y + uy*l/2},
+		{X: tip.X - ux*l/2 - uy*w, Y: tip.Y - uy*l/2 + ux*w},
+		{X: tip.X - ux*l/2 + uy*w, Y: tip.Y - uy*l/2 - ux*w},
+	})
+}
+
+// spiral returns the indices of the cells of an n×n grid, in a
+// spiral order starting from the boundary of the grid.
+func spiral(n int) []int {
+	idx := make([]int, 0, n*n)
+	xlo, xhi, ylo, yhi := 0, n-1, 0, n-1
+	for xlo <= xhi && ylo <= yhi {
+		for i := xlo; i <= xhi; i++ {
+			idx = append(idx, ylo*n+i)
+		}
+		ylo++
+		for j := ylo; j <= yhi; j++ {
+			idx = append(idx, j*n+xhi)
+		}
+		xhi--
+		if ylo <= yhi {
+			for i := xhi; i >= xlo; i-- {
+				idx = append(idx, yhi*n+i)
+			}
+			yhi--
+		}
+		if xlo <= xhi {
+			for j := yhi; j >= ylo; j-- {
+				idx = append(idx, j*n+xlo)
+			}
+			xlo++
+		}
+	}
+	return idx
+}
+
+// DataRange implements the DataRange method
+// of the plot.DataRanger interface.
+func (s *Streamplot) DataRange() (xmin, xmax, ymin, ymax float64) {
+	c, r := s.FieldXY.Dims()
+	return s.FieldXY.X(0), s.FieldXY.X(c - 1), s.FieldXY.Y(0), s.FieldXY.Y(r - 1)
+}
+
+// Thumbnail implements the Thumbnail method
+// of the plot.Thumbnailer interface.
+func (s *Streamplot) Thumbnail(c *draw.Canvas) {
+	y := c.Center().Y
+	c.StrokeLine2(s.style(s.max), c.Min.X, y, c.Max.X, y)
+}
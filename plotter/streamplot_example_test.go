@@ -0,0 +1,43 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"log"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+func ExampleStreamplot() {
+	s := plotter.NewStreamplot(field{
+		r: 21, c: 21,
+		fn: func(x, y float64) plotter.XY {
+			return plotter.XY{
+				X: -1 - x*x + y,
+				Y: 1 + x - y*y,
+			}
+		},
+	})
+	s.Density = 1.5
+	s.LineStyle.Width = vg.Points(0.5)
+	s.MaxWidth = vg.Points(2)
+
+	cmap := moreland.SmoothBlueRed()
+	cmap.SetMin(0)
+	cmap.SetMax(1)
+	s.ColorMap = cmap
+
+	p := plot.New()
+	p.Title.Text = "Streamlines"
+	p.Add(s)
+
+	err := p.Save(250, 250, "testdata/streamplot.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
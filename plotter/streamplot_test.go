@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+	"gonum.org/v1/plot/vg/vgimg"
+)
+
+func TestStreamplot(t *testing.T) {
+	cmpimg.CheckPlot(ExampleStreamplot, t, "streamplot.png")
+}
+
+func TestStreamplotDegenerate(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		f    field
+	}{
+		{
+			name: "single row",
+			f:    field{r: 1, c: 5, fn: func(x, y float64) plotter.XY { return plotter.XY{X: 1} }},
+		},
+		{
+			name: "zero field",
+			f:    field{r: 5, c: 5, fn: func(x, y float64) plotter.XY { return plotter.XY{} }},
+		},
+	} {
+		func() {
+			defer func() {
+				r := recover()
+				if r != nil {
+					t.Errorf("unexpected panic for %s: %v", test.name, r)
+				}
+			}()
+
+			p := plot.New()
+			p.Add(plotter.NewStreamplot(test.f))
+			p.Draw(draw.New(vgimg.New(100, 100)))
+		}()
+	}
+}
+
+func TestStreamplotArrowSpacing(t *testing.T) {
+	for _, test := range []struct {
+		spacing vg.Length
+		arrows  int // arrows is the number of arrows per streamline.
+	}{
+		{spacing: 0, arrows: 1},
+		{spacing: vg.Points(30), arrows: 3},
+		{spacing: vg.Points(45), arrows: 2},
+		{spacing: vg.Points(200), arrows: 1},
+	} {
+		// The streamlines are horizontal lines
+		// spanning the width of the canvas.
+		s := plotter.NewStreamplot(field{
+			r: 5, c: 5,
+			fn: func(x, y float64) plotter.XY { return plotter.XY{X: 1} },
+		})
+		s.Density = 0.2
+		s.ArrowSpacing = test.spacing
+
+		p := plot.New()
+		p.X.Min, p.X.Max, p.Y.Min, p.Y.Max = s.DataRange()
+		rec := new(recorder.Canvas)
+		s.Plot(draw.NewCanvas(rec, vg.Points(100), vg.Points(100)), p)
+
+		var lines, arrows int
+		for _, a := range rec.Actions {
+			switch a.(type) {
+			case *recorder.Stroke:
+				lines++
+			case *recorder.Fill:
+				arrows++
+			}
+		}
+		if lines == 0 {
+			t.Fatalf("no streamline drawn")
+		}
+		if arrows != test.arrows*lines {
+			t.Errorf("unexpected number of arrows for spacing %v: got:%d want:%d×%d",
+				test.spacing, arrows, test.arrows, lines)
+		}
+	}
+}
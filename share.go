@@ -0,0 +1,231 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import "math"
+
+// Sharing specifies which plots of a row-major array of plots
+// share an axis.
+type Sharing int
+
+const (
+	// ShareNone does not share the axis.
+	ShareNone Sharing = iota
+
+	// ShareAll shares the axis among all the plots.
+	ShareAll
+
+	// ShareRow shares the axis among the plots of each row.
+	ShareRow
+
+	// ShareColumn shares the axis among the plots of each column.
+	ShareColumn
+)
+
+// ShareAxes links the X and Y axes of a two-dimensional row-major
+// array of plots, as passed to Align, according to x and y.
+//
+// The axes sharing a range are set to the union of their ranges,
+// which include the data ranges of the plotters added to the
+// plots, and use the Ticker and Scale of the axis of the first
+// plot of the group in row-major order. ShareAxes must thus be
+// called after all the plotters have been added.
+//
+// When X axes are shared among the plots of a column, the tick
+// labels and axis labels of the X axes are hidden, keeping the
+// tick marks and the label text, for all but the lowest plot of
+// the column.
+// Likewise, when Y axes are shared among the plots of a row,
+// they are hidden for all but the leftmost plot of the row.
+//
+// A plot spanning several rows or columns is repeated in each
+// of the cells it spans. It shares its axes with the plots of
+// all the rows or columns it spans, and its labels are only
+// hidden when there are plots below, or on the left of, each
+// of them.
+//
+// Nil plots are ignored.
+func ShareAxes(plots [][]*Plot, x, y Sharing) {
+	share(plots, x, func(p *Plot) *Axis { return &p.X })
+	share(plots, y, func(p *Plot) *Axis { return &p.Y })
+
+	for j, row := range plots {
+		for i, p := range row {
+			if p == nil || repeated(plots, i, j) {
+				continue
+			}
+			if (x == ShareAll || x == ShareColumn) && below(plots, i, j) {
+				p.X.Tick.HideLabels = true
+				p.X.Label.Hide = true
+			}
+			if (y == ShareAll || y == ShareRow) && left(plots, i, j) {
+				p.Y.Tick.HideLabels = true
+				p.Y.Label.Hide = true
+			}
+		}
+	}
+}
+
+// share links the axes returned by axis for the plots
+// grouped according to s.
+func share(plots [][]*Plot, s Sharing, axis func(*Plot) *Axis) {
+	var groups [][]*Axis
+	switch s {
+	case ShareNone:
+		return
+	case ShareAll:
+		var g []*Axis
+		for _, row := range plots {
+			for _, p := range row {
+				if p != nil {
+					g = appendAxis(g, axis(p))
+				}
+			}
+		}
+		groups = append(groups, g)
+	case ShareRow:
+		for _, row := range plots {
+			var g []*Axis
+			for _, p := range row {
+				if p != nil {
+					g = appendAxis(g, axis(p))
+				}
+			}
+			groups = append(groups, g)
+		}
+	case ShareColumn:
+		for _, row := range plots {
+			for i, p := range row {
+				for len(groups) <= i {
+					groups = append(groups, nil)
+				}
+				if p != nil {
+					groups[i] = appendAxis(groups[i], axis(p))
+				}
+			}
+		}
+	default:
+		panic("plot: unknown axis sharing")
+	}
+
+	// Plots spanning several rows or columns
+	// join the groups of all of them.
+	for i := 0; i < len(groups); i++ {
+		for k := i + 1; k < len(groups); k++ {
+			if !overlap(groups[i], groups[k]) {
+				continue
+			}
+			for _, a := range groups[k] {
+				groups[i] = appendAxis(groups[i], a)
+			}
+			groups = append(groups[:k], groups[k+1:]...)
+			k = i
+		}
+	}
+
+	for _, g := range groups {
+		if len(g) == 0 {
+			continue
+		}
+		min, max := math.Inf(+1), math.Inf(-1)
+		for _, a := range g {
+			min = math.Min(min, a.Min)
+			max = math.Max(max, a.Max)
+		}
+		for _, a := range g {
+			a.Min, a.Max = min, max
+			a.Tick.Marker = g[0].Tick.Marker
+			a.Scale = g[0].Scale
+		}
+	}
+}
+
+// appendAxis appends a to g unless g already holds it.
+func appendAxis(g []*Axis, a *Axis) []*Axis {
+	for _, b := range g {
+		if a == b {
+			return g
+		}
+	}
+	return append(g, a)
+}
+
+// overlap returns whether the groups g and h hold a common axis.
+func overlap(g, h []*Axis) bool {
+	for _, a := range g {
+		for _, b := range h {
+			if a == b {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// repeated returns whether the plot at column i, row j
+// is also in the cell above it or on its left, so that
+// it is not the top left cell of the plot.
+func repeated(plots [][]*Plot, i, j int) bool {
+	p := plots[j][i]
+	if i > 0 && plots[j][i-1] == p {
+		return true
+	}
+	return j > 0 && i < len(plots[j-1]) && plots[j-1][i] == p
+}
+
+// span returns the last row and column of the cells
+// spanned by the plot whose top left cell is at
+// column i, row j.
+func span(plots [][]*Plot, i, j int) (r1, c1 int) {
+	p := plots[j][i]
+	r1, c1 = j, i
+	for r1+1 < len(plots) && i < len(plots[r1+1]) && plots[r1+1][i] == p {
+		r1++
+	}
+	for c1+1 < len(plots[j]) && plots[j][c1+1] == p {
+		c1++
+	}
+	return r1, c1
+}
+
+// below returns whether there is a plot below the bottom
+// row of the plot whose top left cell is at column i,
+// row j, in each of the columns it spans.
+func below(plots [][]*Plot, i, j int) bool {
+	r1, c1 := span(plots, i, j)
+	for c := i; c <= c1; c++ {
+		found := false
+		for _, row := range plots[r1+1:] {
+			if c < len(row) && row[c] != nil {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// left returns whether there is a plot on the left of
+// the left column of the plot whose top left cell is at
+// column i, row j, in each of the rows it spans.
+func left(plots [][]*Plot, i, j int) bool {
+	r1, _ := span(plots, i, j)
+	for r := j; r <= r1; r++ {
+		found := false
+		for _, p := range plots[r][:min(i, len(plots[r]))] {
+			if p != nil {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
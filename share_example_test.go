@@ -0,0 +1,82 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"os"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
+)
+
+// ExampleShareAxes draws small multiples sharing their X and Y
+// axes, with the inner tick labels and axis labels hidden.
+func ExampleShareAxes() {
+	rnd := rand.New(rand.NewPCG(1, 1))
+
+	const rows, cols = 2, 2
+	plots := make([][]*plot.Plot, rows)
+	for j := range rows {
+		plots[j] = make([]*plot.Plot, cols)
+		for i := range cols {
+			k := j*cols + i
+			pts := make(plotter.XYs, 50)
+			for n := range pts {
+				pts[n].X = rnd.NormFloat64() * float64(k+1)
+				pts[n].Y = float64(k) + rnd.NormFloat64()
+			}
+			s, err := plotter.NewScatter(pts)
+			if err != nil {
+				log.Panic(err)
+			}
+
+			p := plot.New()
+			p.Title.Text = fmt.Sprintf("Sample %d", k+1)
+			p.X.Label.Text = "X"
+			p.Y.Label.Text = "Y"
+			p.Add(s)
+			plots[j][i] = p
+		}
+	}
+
+	plot.ShareAxes(plots, plot.ShareAll, plot.ShareAll)
+
+	img := vgimg.New(vg.Points(300), vg.Points(250))
+	dc := draw.New(img)
+
+	t := draw.Tiles{
+		Rows:      rows,
+		Cols:      cols,
+		PadX:      vg.Millimeter,
+		PadY:      vg.Millimeter,
+		PadTop:    vg.Points(2),
+		PadBottom: vg.Points(2),
+		PadLeft:   vg.Points(2),
+		PadRight:  vg.Points(2),
+	}
+
+	canvases := plot.Align(plots, t, dc)
+	for j := range rows {
+		for i := range cols {
+			plots[j][i].Draw(canvases[j][i])
+		}
+	}
+
+	w, err := os.Create("testdata/share_axes.png")
+	if err != nil {
+		log.Panic(err)
+	}
+	defer w.Close()
+	png := vgimg.PngCanvas{Canvas: img}
+	if _, err := png.WriteTo(w); err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+)
+
+func TestShareAxes(t *testing.T) {
+	cmpimg.CheckPlot(ExampleShareAxes, t, "share_axes.png")
+}
+
+func TestShareAxesRanges(t *testing.T) {
+	newPlot := func(xmin, xmax, ymin, ymax float64) *plot.Plot {
+		p := plot.New()
+		p.X.Min, p.X.Max = xmin, xmax
+		p.Y.Min, p.Y.Max = ymin, ymax
+		p.X.Label.Text = "X"
+		p.Y.Label.Text = "Y"
+		return p
+	}
+
+	for _, test := range []struct {
+		x, y plot.Sharing
+
+		// x and y are the wanted [min, max]
+		// ranges of the axes of each plot.
+		xr, yr [][][2]float64
+
+		// hideX and hideY are whether the labels
+		// of the axes of each plot are hidden.
+		hideX, hideY [][]bool
+	}{
+		{
+			x: plot.ShareNone, y: plot.ShareNone,
+			xr:    [][][2]float64{{{0, 1}, {2, 3}, {}}, {{4, 5}, {6, 7}, {8, 9}}},
+			yr:    [][][2]float64{{{0, 1}, {2, 3}, {}}, {{4, 5}, {6, 7}, {8, 9}}},
+			hideX: [][]bool{{false, false, false}, {false, false, false}},
+			hideY: [][]bool{{false, false, false}, {false, false, false}},
+		},
+		{
+			x: plot.ShareAll, y: plot.ShareAll,
+			xr:    [][][2]float64{{{0, 9}, {0, 9}, {}}, {{0, 9}, {0, 9}, {0, 9}}},
+			yr:    [][][2]float64{{{0, 9}, {0, 9}, {}}, {{0, 9}, {0, 9}, {0, 9}}},
+			hideX: [][]bool{{true, true, false}, {false, false, false}},
+			hideY: [][]bool{{false, true, false}, {false, true, true}},
+		},
+		{
+			x: plot.ShareColumn, y: plot.ShareRow,
+			xr:    [][][2]float64{{{0, 5}, {2, 7}, {}}, {{0, 5}, {2, 7}, {8, 9}}},
+			yr:    [][][2]float64{{{0, 3}, {0, 3}, {}}, {{4, 9}, {4, 9}, {4, 9}}},
+			hideX: [][]bool{{true, true, false}, {false, false, false}},
+			hideY: [][]bool{{false, true, false}, {false, true, true}},
+		},
+		{
+			x: plot.ShareRow, y: plot.ShareColumn,
+			xr:    [][][2]float64{{{0, 3}, {0, 3}, {}}, {{4, 9}, {4, 9}, {4, 9}}},
+			yr:    [][][2]float64{{{0, 5}, {2, 7}, {}}, {{0, 5}, {2, 7}, {8, 9}}},
+			hideX: [][]bool{{false, false, false}, {false, false, false}},
+			hideY: [][]bool{{false, false, false}, {false, false, false}},
+		},
+	} {
+		plots := [][]*plot.Plot{
+			{newPlot(0, 1, 0, 1), newPlot(2, 3, 2, 3), nil},
+			{newPlot(4, 5, 4, 5), newPlot(6, 7, 6, 7), newPlot(8, 9, 8, 9)},
+		}
+		plot.ShareAxes(plots, test.x, test.y)
+		for j, row := range plots {
+			for i, p := range row {
+				if p == nil {
+					continue
+				}
+				if got, want := [2]float64{p.X.Min, p.X.Max}, test.xr[j][i]; got != want {
+					t.Errorf("unexpected X range for sharing (%d, %d) at (%d, %d): got:%v want:%v", test.x, test.y, i, j, got, want)
+				}
+				if got, want := [2]float64{p.Y.Min, p.Y.Max}, test.yr[j][i]; got != want {
+					t.Errorf("unexpected Y range for sharing (%d, %d) at (%d, %d): got:%v want:%v", test.x, test.y, i, j, got, want)
+				}
+				if got, want := p.X.Tick.HideLabels, test.hideX[j][i]; got != want {
+					t.Errorf("unexpected X labels hiding for sharing (%d, %d) at (%d, %d): got:%t want:%t", test.x, test.y, i, j, got, want)
+				}
+				if got, want := p.X.Label.Hide, test.hideX[j][i]; got != want {
+					t.Errorf("unexpected X label hiding for sharing (%d, %d) at (%d, %d): got:%t want:%t", test.x, test.y, i, j, got, want)
+				}
+				if got, want := p.X.Label.Text, "X"; got != want {
+					t.Errorf("unexpected X label for sharing (%d, %d) at (%d, %d): got:%q want:%q", test.x, test.y, i, j, got, want)
+				}
+				if got, want := p.Y.Tick.HideLabels, test.hideY[j][i]; got != want {
+					t.Errorf("unexpected Y labels hiding for sharing (%d, %d) at (%d, %d): got:%t want:%t", test.x, test.y, i, j, got, want)
+				}
+				if got, want := p.Y.Label.Hide, test.hideY[j][i]; got != want {
+					t.Errorf("unexpected Y label hiding for sharing (%d, %d) at (%d, %d): got:%t want:%t", test.x, test.y, i, j, got, want)
+				}
+				if got, want := p.Y.Label.Text, "Y"; got != want {
+					t.Errorf("unexpected Y label for sharing (%d, %d) at (%d, %d): got:%q want:%q", test.x, test.y, i, j, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestShareAxesSpan(t *testing.T) {
+	newPlot := func(min, max float64) *plot.Plot {
+		p := plot.New()
+		p.X.Min, p.X.Max = min, max
+		p.Y.Min, p.Y.Max = min, max
+		return p
+	}
+
+	// The first plot spans the two rows of the first column.
+	span := newPlot(0, 1)
+	top := newPlot(2, 3)
+	bottom := newPlot(4, 5)
+	plots := [][]*plot.Plot{
+		{span, top},
+		{span, bottom},
+	}
+	plot.ShareAxes(plots, plot.ShareColumn, plot.ShareRow)
+
+	for _, test := range []struct {
+		name         string
+		p            *plot.Plot
+		xr, yr       [2]float64
+		hideX, hideY bool
+	}{
+		{name: "span", p: span, xr: [2]float64{0, 1}, yr: [2]float64{0, 5}, hideX: false, hideY: false},
+		{name: "top", p: top, xr: [2]float64{2, 5}, yr: [2]float64{0, 5}, hideX: true, hideY: true},
+		{name: "bottom", p: bottom, xr: [2]float64{2, 5}, yr: [2]float64{0, 5}, hideX: false, hideY: true},
+	} {
+		if got, want := [2]float64{test.p.X.Min, test.p.X.Max}, test.xr; got != want {
+			t.Errorf("unexpected X range of %s plot: got:%v want:%v", test.name, got, want)
+		}
+		if got, want := [2]float64{test.p.Y.Min, test.p.Y.Max}, test.yr; got != want {
+			t.Errorf("unexpected Y range of %s plot: got:%v want:%v", test.name, got, want)
+		}
+		if got, want := test.p.X.Tick.HideLabels, test.hideX; got != want {
+			t.Errorf("unexpected X labels hiding of %s plot: got:%t want:%t", test.name, got, want)
+		}
+		if got, want := test.p.Y.Tick.HideLabels, test.hideY; got != want {
+			t.Errorf("unexpected Y labels hiding of %s plot: got:%t want:%t", test.name, got, want)
+		}
+	}
+}
@@ -11,16 +11,37 @@ import (
 	"strings"
 
 	"codeberg.org/go-latex/latex/drawtex"
-	"codeberg.org/go-latex/latex/font/ttf"
+	lfont "codeberg.org/go-latex/latex/font"
 	"codeberg.org/go-latex/latex/mtex"
 	"codeberg.org/go-latex/latex/tex"
-	stdfnt "golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
 
 	"gonum.org/v1/plot/font"
 	"gonum.org/v1/plot/vg"
 )
 
 // Latex parses, formats and renders LaTeX.
+//
+// Text is drawn in text mode, and math between dollar signs, as in
+// `$\sum_{i=0}^{n} x_i^2$`. Math is typeset with go-latex, whose
+// fractions, binomial coefficients, roots, \overline, spaces, Greek
+// letters and symbols are extended with:
+//
+//   - superscripts, subscripts and primes,
+//   - accents, such as \hat, \bar, \vec, \tilde and \widehat,
+//     and \underline,
+//   - fonts, with \mathrm, \mathit, \mathbf, \boldsymbol,
+//     \mathsf, \mathtt, \mathcal and \text,
+//   - big operators, such as \sum and \int, and functions, such
+//     as \lim and \sin, with limits drawn above and below them
+//     in display style, or with \limits,
+//   - delimiters grown with \left and \right,
+//   - the matrix, pmatrix, bmatrix, Bmatrix, vmatrix, Vmatrix
+//     and cases environments,
+//   - the math styles, set with \displaystyle and \textstyle.
+//
+// Invalid LaTeX text is drawn verbatim. Validate reports
+// the errors of such text.
 type Latex struct {
 	// Fonts is the cache of font faces used by this text handler.
 	Fonts *font.Cache
@@ -56,11 +77,7 @@ func (hdlr Latex) Lines(txt string) []string {
 func (hdlr Latex) Box(txt string, fnt font.Font) (width, height, depth vg.Length) {
 	cnv := drawtex.New()
 	face := hdlr.Fonts.Lookup(fnt, fnt.Size)
-	fnts := hdlr.fontsFor(fnt)
-	box, err := mtex.Parse(txt, face.Font.Size.Points(), latexDPI, ttf.NewFrom(cnv, fnts))
-	if err != nil {
-		panic(fmt.Errorf("could not parse math expression: %w", err))
-	}
+	box, _ := hdlr.typeset(cnv, txt, fnt)
 
 	var sh tex.Ship
 	sh.Call(0, 0, box.(tex.Tree))
@@ -89,11 +106,7 @@ func (hdlr Latex) Box(txt string, fnt font.Font) (width, height, depth vg.Length
 func (hdlr Latex) Draw(c vg.Canvas, txt string, sty Style, pt vg.Point) {
 	cnv := drawtex.New()
 	face := hdlr.Fonts.Lookup(sty.Font, sty.Font.Size)
-	fnts := hdlr.fontsFor(sty.Font)
-	box, err := mtex.Parse(txt, face.Font.Size.Points(), latexDPI, ttf.NewFrom(cnv, fnts))
-	if err != nil {
-		panic(fmt.Errorf("could not parse math expression: %w", err))
-	}
+	box, be := hdlr.typeset(cnv, txt, sty.Font)
 
 	var sh tex.Ship
 	sh.Call(0, 0, box.(tex.Tree))
@@ -106,6 +119,7 @@ func (hdlr Latex) Draw(c vg.Canvas, txt string, sty Style, pt vg.Point) {
 	o := latex{
 		cnv:   c,
 		fonts: hdlr.Fonts,
+		faces: be.faces,
 		sty:   sty,
 		pt:    pt,
 		w:     vg.Length(w * dpi),
@@ -127,35 +141,117 @@ func (hdlr Latex) Draw(c vg.Canvas, txt string, sty Style, pt vg.Point) {
 		o.cnv.Rotate(sty.Rotation)
 	}
 
-	err = o.Render(w/latexDPI, (h+d)/latexDPI, dpi, cnv)
+	err := o.Render(w/latexDPI, (h+d)/latexDPI, dpi, cnv)
 	if err != nil {
 		panic(fmt.Errorf("could not render math expression: %w", err))
 	}
 }
 
-func (hdlr *Latex) fontsFor(fnt font.Font) *ttf.Fonts {
-	rm := fnt
-	rm.Variant = "Serif"
-	rm.Weight = stdfnt.WeightNormal
-	rm.Style = stdfnt.StyleNormal
-
-	it := rm
-	it.Style = stdfnt.StyleItalic
+// Validate returns an error if the given text is not valid LaTeX,
+// reporting the position of the first error in the offending line.
+// Box and Draw do not fail on invalid text, which they draw verbatim.
+func (hdlr Latex) Validate(txt string) error {
+	for _, line := range hdlr.Lines(txt) {
+		_, err := parseLatex(line)
+		if err != nil {
+			return fmt.Errorf("text: invalid LaTeX text %q: %w", line, err)
+		}
+	}
+	return nil
+}
 
-	bf := rm
-	bf.Style = stdfnt.StyleNormal
-	bf.Weight = stdfnt.WeightBold
+// typeset lays out a line of text drawn with the font fnt, whose
+// glyphs are rendered on cnv. Text that mtex typesets is laid out
+// by mtex, and the other text by a texBuilder. Invalid LaTeX text
+// is laid out verbatim.
+func (hdlr Latex) typeset(cnv *drawtex.Canvas, txt string, fnt font.Font) (tex.Node, *latexBackend) {
+	var (
+		be   = newLatexBackend(cnv, hdlr.Fonts, fnt)
+		size = hdlr.Fonts.Lookup(fnt, fnt.Size).Font.Size.Points()
+	)
+	list, err := parseLatex(txt)
+	if err == nil && mtexText(txt, list) {
+		if box, ok := mtexParse(txt, size, be); ok {
+			return box, be
+		}
+	}
+	if err != nil {
+		list = list[:0]
+		for _, r := range txt {
+			list = append(list, texChar{r})
+		}
+	}
+	st := texState{
+		State: tex.NewState(be, lfont.Font{Name: "default", Type: "rm", Size: size}, latexDPI),
+		style: textStyle,
+	}
+	return texBuilder{}.hlist(list, st), be
+}
 
-	bfit := bf
-	bfit.Style = stdfnt.StyleItalic
+// mtexParse returns the box of the text laid out by mtex, and
+// whether mtex could lay it out.
+func mtexParse(txt string, size float64, be lfont.Backend) (box tex.Node, ok bool) {
+	defer func() {
+		if recover() != nil {
+			box, ok = nil, false
+		}
+	}()
+	box, err := mtex.Parse(txt, size, latexDPI, be)
+	return box, err == nil
+}
 
-	return &ttf.Fonts{
-		Rm:      hdlr.Fonts.Lookup(rm, fnt.Size).Face,
-		Default: hdlr.Fonts.Lookup(rm, fnt.Size).Face,
-		It:      hdlr.Fonts.Lookup(it, fnt.Size).Face,
-		Bf:      hdlr.Fonts.Lookup(bf, fnt.Size).Face,
-		BfIt:    hdlr.Fonts.Lookup(bfit, fnt.Size).Face,
+// mtexText returns whether the parsed text only holds constructs
+// that mtex typesets: plain text, and math of characters, symbols,
+// spaces, fractions, roots and overlines.
+func mtexText(txt string, list texList) bool {
+	var math bool
+	for _, r := range txt {
+		switch {
+		case r == '$':
+			math = !math
+		case !math && strings.ContainsRune(`\{}~%`, r):
+			// Text mode commands are typeset by the builder.
+			return false
+		}
 	}
+	var mtexList func(list texList) bool
+	mtexList = func(list texList) bool {
+		for _, n := range list {
+			switch n := n.(type) {
+			case texChar, texSpace:
+			case *texSym:
+				if _, ok := texAliases[n.name]; ok || n.limits != 0 {
+					return false
+				}
+			case texGroup:
+				if !mtexList(n.list) {
+					return false
+				}
+			case texMath:
+				if !mtexList(n.list) {
+					return false
+				}
+			case *texCmd:
+				switch n.name {
+				case `\frac`, `\dfrac`, `\tfrac`, `\binom`, `\sqrt`, `\overline`:
+				default:
+					return false
+				}
+				if !mtexList(n.opt) {
+					return false
+				}
+				for _, arg := range n.args {
+					if !mtexList(arg) {
+						return false
+					}
+				}
+			default:
+				return false
+			}
+		}
+		return true
+	}
+	return mtexList(list)
 }
 
 // latexDPI is the default LaTeX resolution used for computing the LaTeX
@@ -173,6 +269,7 @@ func (hdlr Latex) dpi() float64 {
 type latex struct {
 	cnv   vg.Canvas
 	fonts *font.Cache
+	faces map[*sfnt.Font]font.Font
 	sty   Style
 	pt    vg.Point
 
@@ -216,8 +313,12 @@ func (r *latex) drawGlyph(dpi float64, op drawtex.GlyphOp) {
 		Y: r.yoff - vg.Length(op.Y*dpi),
 	})
 
+	desc, ok := r.faces[op.Glyph.Font]
+	if !ok {
+		desc = r.sty.Font
+	}
 	fnt := font.Face{
-		Font: font.From(r.sty.Font, vg.Length(op.Glyph.Size)),
+		Font: font.From(desc, vg.Length(op.Glyph.Size)),
 		Face: op.Glyph.Font,
 	}
 	r.cnv.FillString(fnt, pt, op.Glyph.Symbol)
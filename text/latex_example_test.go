@@ -84,3 +84,70 @@ func ExampleLatex() {
 func TestLatex(t *testing.T) {
 	cmpimg.CheckPlot(ExampleLatex, t, "latex_"+runtime.GOARCH+".png")
 }
+
+func ExampleLatex_math() {
+	hdlr := text.Latex{
+		Fonts: font.NewCache(liberation.Collection()),
+	}
+	plot.DefaultTextHandler = hdlr
+
+	var (
+		title  = `$\hat{\sigma}^2 = \frac{1}{n-1} \sum_{i=1}^{n} \left(x_i - \bar{x}\right)^2$`
+		xlabel = `$\vec{F} = m\,\mathbf{a}$ [$\mathrm{N}$]`
+		ylabel = `$\det \begin{pmatrix} a & b \\ c & d \end{pmatrix}$`
+		labels = []string{
+			`$\displaystyle\int_0^\infty e^{-x^2} dx = \frac{\sqrt{\pi}}{2}$`,
+			`$|x| = \begin{cases} x & x \geq 0 \\ -x & x < 0 \end{cases}$`,
+			`$\displaystyle\lim_{n \to \infty} \left(1 + \frac{1}{n}\right)^n = e$`,
+			`$\binom{n}{k}, \sqrt[3]{\tilde{x}'}, \mathcal{L}$`,
+		}
+	)
+
+	// Validate reports the errors of invalid LaTeX text,
+	// which would be drawn verbatim.
+	for _, txt := range append([]string{title, xlabel, ylabel}, labels...) {
+		err := hdlr.Validate(txt)
+		if err != nil {
+			log.Fatalf("invalid label: %+v", err)
+		}
+	}
+
+	p := plot.New()
+	p.Title.Text = title
+	p.X.Label.Text = xlabel
+	p.Y.Label.Text = ylabel
+
+	p.X.Min = -1
+	p.X.Max = +1
+	p.Y.Min = -1
+	p.Y.Max = +1
+
+	lbls, err := plotter.NewLabels(plotter.XYLabels{
+		XYs: []plotter.XY{
+			{X: -0.9, Y: +0.5},
+			{X: +0.1, Y: +0.5},
+			{X: -0.9, Y: -0.5},
+			{X: +0.1, Y: -0.5},
+		},
+		Labels: labels,
+	})
+	if err != nil {
+		log.Fatalf("could not create labels: %+v", err)
+	}
+	for i := range lbls.TextStyle {
+		lbls.TextStyle[i].Font.Size = 12
+		lbls.TextStyle[i].YAlign = draw.YCenter
+	}
+
+	p.Add(lbls)
+	p.Add(plotter.NewGrid())
+
+	err = p.Save(12*vg.Centimeter, 8*vg.Centimeter, "testdata/latex_math.png")
+	if err != nil {
+		log.Fatalf("could not save plot: %+v", err)
+	}
+}
+
+func TestLatexMathPlot(t *testing.T) {
+	cmpimg.CheckPlotApprox(ExampleLatex_math, t, 0.01, "latex_math.png")
+}
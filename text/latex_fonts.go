@@ -0,0 +1,258 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package text
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"codeberg.org/go-latex/latex/drawtex"
+	lfont "codeberg.org/go-latex/latex/font"
+	"codeberg.org/go-latex/latex/font/ttf"
+	stdfnt "golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+
+	"gonum.org/v1/plot/font"
+)
+
+// latexBackend is the go-latex font backend of the Latex handler.
+//
+// It draws the font types of the go-latex TrueType backend with the
+// serif faces of the font cache, and adds the sans-serif ("sf"),
+// typewriter ("tt") and bold italic ("bfit") font types. Symbols
+// given as non-ASCII runes, which the TrueType backend only knows
+// by their TeX names, are drawn with the faces of the font cache,
+// or of its fallback collections if these faces have no glyph for
+// them.
+type latexBackend struct {
+	cnv   *drawtex.Canvas
+	fonts *font.Cache
+	fnt   font.Font
+
+	ttf   *ttf.Backend            // ttf draws the standard font types.
+	extra map[string]*ttf.Backend // extra draws the added font types.
+
+	// faces are the font descriptors of the faces drawn by
+	// the backend, used by the renderer.
+	faces map[*sfnt.Font]font.Font
+
+	glyphs map[latexGlyphKey]drawtex.Glyph
+}
+
+type latexGlyphKey struct {
+	r    rune
+	font lfont.Font
+}
+
+var _ lfont.Backend = (*latexBackend)(nil)
+
+func newLatexBackend(cnv *drawtex.Canvas, fonts *font.Cache, fnt font.Font) *latexBackend {
+	be := &latexBackend{
+		cnv:    cnv,
+		fonts:  fonts,
+		fnt:    fnt,
+		extra:  make(map[string]*ttf.Backend),
+		faces:  make(map[*sfnt.Font]font.Font),
+		glyphs: make(map[latexGlyphKey]drawtex.Glyph),
+	}
+	be.ttf = ttf.NewFrom(cnv, &ttf.Fonts{
+		Rm:      be.face("rm").Face,
+		Default: be.face("rm").Face,
+		It:      be.face("it").Face,
+		Bf:      be.face("bf").Face,
+		BfIt:    be.face("bfit").Face,
+	})
+	for _, typ := range []string{"sf", "tt", "bfit"} {
+		up := be.face(typ)
+		if typ == "bfit" {
+			// Only letters are slanted.
+			up = be.face("bf")
+		}
+		be.extra[typ] = ttf.NewFrom(cnv, &ttf.Fonts{
+			Rm:      up.Face,
+			Default: up.Face,
+			It:      be.face(typ).Face,
+			Bf:      up.Face,
+		})
+	}
+	return be
+}
+
+// face returns the font face of the font type typ.
+func (be *latexBackend) face(typ string) font.Face {
+	fnt := be.fnt
+	fnt.Variant = "Serif"
+	fnt.Weight = stdfnt.WeightNormal
+	fnt.Style = stdfnt.StyleNormal
+	switch typ {
+	case "it":
+		fnt.Style = stdfnt.StyleItalic
+	case "bf":
+		fnt.Weight = stdfnt.WeightBold
+	case "bfit":
+		fnt.Weight = stdfnt.WeightBold
+		fnt.Style = stdfnt.StyleItalic
+	case "sf":
+		fnt.Variant = "Sans"
+	case "tt":
+		fnt.Variant = "Mono"
+	}
+	face := be.fonts.Lookup(fnt, be.fnt.Size)
+	be.faces[face.Face] = face.Font
+	return face
+}
+
+// backend returns the TrueType backend drawing the font fnt,
+// and the font to draw with it.
+func (be *latexBackend) backend(fnt lfont.Font) (*ttf.Backend, lfont.Font) {
+	switch typ := fnt.Type; typ {
+	case "sf", "tt":
+		fnt.Type = "rm"
+		return be.extra[typ], fnt
+	case "bfit":
+		fnt.Type = "it"
+		return be.extra["bfit"], fnt
+	}
+	return be.ttf, fnt
+}
+
+// rune returns the non-ASCII rune of a symbol, which is drawn
+// by the backend instead of the TrueType backend.
+func latexRune(symbol string) (rune, bool) {
+	r, n := utf8.DecodeRuneInString(symbol)
+	return r, r >= utf8.RuneSelf && n == len(symbol)
+}
+
+// glyph returns the glyph of the non-ASCII rune r drawn with fnt.
+func (be *latexBackend) glyph(r rune, fnt lfont.Font) drawtex.Glyph {
+	key := latexGlyphKey{r: r, font: fnt}
+	if g, ok := be.glyphs[key]; ok {
+		return g
+	}
+
+	typ := fnt.Type
+	switch {
+	case typ == "default", typ == "regular":
+		typ = "rm"
+	case typ == "it" && !unicode.IsLetter(r):
+		// As with the TrueType backend, only letters are slanted.
+		typ = "rm"
+	case typ == "bfit" && !unicode.IsLetter(r):
+		typ = "bf"
+	}
+	face := be.face(typ)
+	if runs := be.fonts.Runs(face.Font, face.Font.Size, string(r)); len(runs) == 1 {
+		face = runs[0].Face
+		be.faces[face.Face] = face.Font
+	}
+
+	var (
+		buf  sfnt.Buffer
+		ppem = fixed.I(12)
+	)
+	idx, err := face.Face.GlyphIndex(&buf, r)
+	if err != nil {
+		panic(fmt.Errorf("could not retrieve glyph index for %q: %+v", r, err))
+	}
+	adv, err := face.Face.GlyphAdvance(&buf, idx, ppem, stdfnt.HintingNone)
+	if err != nil {
+		panic(fmt.Errorf("could not retrieve glyph advance for %q: %+v", r, err))
+	}
+	bnds, _, err := face.Face.GlyphBounds(&buf, idx, ppem, stdfnt.HintingNone)
+	if err != nil {
+		panic(fmt.Errorf("could not retrieve glyph bounds for %q: %+v", r, err))
+	}
+
+	// The metrics follow the conventions of the TrueType backend.
+	var (
+		scale = fnt.Size / 12 / 64
+		xmin  = scale * float64(bnds.Min.X)
+		xmax  = scale * float64(bnds.Max.X)
+		ymin  = scale * float64(-bnds.Max.Y)
+		ymax  = scale * float64(-bnds.Min.Y)
+	)
+	g := drawtex.Glyph{
+		Font: face.Face,
+		Size: fnt.Size,
+		Metrics: lfont.Metrics{
+			Advance: scale * float64(adv),
+			Height:  ymax - ymin,
+			Width:   xmax - xmin,
+			XMin:    xmin,
+			XMax:    xmax,
+			YMin:    ymin,
+			YMax:    ymax,
+			Iceberg: ymax,
+			Slanted: typ == "it" || typ == "bfit",
+		},
+		Symbol: string(r),
+		Num:    idx,
+	}
+	be.glyphs[key] = g
+	return g
+}
+
+// has returns whether the faces of the font type typ, or their
+// fallbacks, have a glyph for the rune r.
+func (be *latexBackend) has(typ string, r rune) bool {
+	face := be.glyph(r, lfont.Font{Type: typ, Size: be.fnt.Size.Points()}).Font
+	var buf sfnt.Buffer
+	idx, err := face.GlyphIndex(&buf, r)
+	return err == nil && idx != 0
+}
+
+// RenderGlyph renders the glyph g at the reference point (x,y).
+func (be *latexBackend) RenderGlyph(x, y float64, fnt lfont.Font, symbol string, dpi float64) {
+	if r, ok := latexRune(symbol); ok {
+		be.cnv.RenderGlyph(x, y, be.glyph(r, fnt))
+		return
+	}
+	ttf, fnt := be.backend(fnt)
+	ttf.RenderGlyph(x, y, fnt, symbol, dpi)
+}
+
+// RenderRectFilled draws a filled black rectangle from (x1,y1) to (x2,y2).
+func (be *latexBackend) RenderRectFilled(x1, y1, x2, y2 float64) {
+	be.cnv.RenderRectFilled(x1, y1, x2, y2)
+}
+
+// Kern returns the kerning distance between two symbols.
+func (be *latexBackend) Kern(ft1 lfont.Font, sym1 string, ft2 lfont.Font, sym2 string, dpi float64) float64 {
+	if _, ok := latexRune(sym1); ok {
+		return 0
+	}
+	if _, ok := latexRune(sym2); ok {
+		return 0
+	}
+	ttf1, ft1 := be.backend(ft1)
+	ttf2, ft2 := be.backend(ft2)
+	if ttf1 != ttf2 {
+		return 0
+	}
+	return ttf1.Kern(ft1, sym1, ft2, sym2, dpi)
+}
+
+// Metrics returns the metrics.
+func (be *latexBackend) Metrics(symbol string, fnt lfont.Font, dpi float64, math bool) lfont.Metrics {
+	if r, ok := latexRune(symbol); ok {
+		return be.glyph(r, fnt).Metrics
+	}
+	ttf, fnt := be.backend(fnt)
+	return ttf.Metrics(symbol, fnt, dpi, math)
+}
+
+// XHeight returns the xheight for the given font and dpi.
+func (be *latexBackend) XHeight(fnt lfont.Font, dpi float64) float64 {
+	ttf, fnt := be.backend(fnt)
+	return ttf.XHeight(fnt, dpi)
+}
+
+// UnderlineThickness returns the line thickness that matches the given font.
+func (be *latexBackend) UnderlineThickness(fnt lfont.Font, dpi float64) float64 {
+	ttf, fnt := be.backend(fnt)
+	return ttf.UnderlineThickness(fnt, dpi)
+}
@@ -0,0 +1,568 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package text
+
+import (
+	"math"
+
+	"codeberg.org/go-latex/latex/tex"
+)
+
+// mathStyle is a TeX math style, setting the size of math
+// and the placement of limits.
+type mathStyle uint8
+
+const (
+	displayStyle mathStyle = iota
+	textStyle
+	scriptStyle
+	scriptScriptStyle
+)
+
+// script returns the style of the scripts of math in style s.
+func (s mathStyle) script() mathStyle {
+	if s < scriptStyle {
+		return scriptStyle
+	}
+	return scriptScriptStyle
+}
+
+// frac returns the style of the numerators and denominators
+// of fractions in style s.
+func (s mathStyle) frac() mathStyle {
+	if s == displayStyle {
+		return textStyle
+	}
+	return s.script()
+}
+
+// level returns the size level of the style s.
+func (s mathStyle) level() int {
+	if s < scriptStyle {
+		return 0
+	}
+	return int(s - textStyle)
+}
+
+// resize shrinks or grows the node n built in the style from
+// to the size of the style to.
+func resize(n tex.Node, from, to mathStyle) {
+	for i := from.level(); i < to.level(); i++ {
+		n.Shrink()
+	}
+	for i := to.level(); i < from.level(); i++ {
+		n.Grow()
+	}
+}
+
+// texState is the state of a texBuilder.
+type texState struct {
+	tex.State
+	math  bool
+	style mathStyle
+}
+
+// texBuilder builds the go-latex box model of parsed LaTeX
+// text, for the math constructs that mtex does not typeset.
+// It typesets them as mtex typesets fractions and roots, with
+// the nodes and font constants of the go-latex tex package.
+type texBuilder struct{}
+
+// hlist returns the horizontal list of the nodes of list.
+func (b texBuilder) hlist(list texList, st texState) *tex.HList {
+	var (
+		nodes []tex.Node
+		prev  = openAtom
+	)
+	for _, n := range list {
+		if n, ok := n.(texStyleCmd); ok {
+			st.style = n.style
+			continue
+		}
+		node, class := b.node(n, st)
+		if !st.math || st.style >= scriptStyle {
+			nodes = append(nodes, node)
+			continue
+		}
+		if class == binAtom && prev != ordAtom {
+			// Binary operators following an operator or
+			// starting a list are unary.
+			class = ordAtom
+		}
+		switch class {
+		case binAtom, relAtom:
+			nodes = append(nodes, b.space(st, 0.2), node, b.space(st, 0.2))
+		case punctAtom:
+			nodes = append(nodes, node, b.space(st, 0.2))
+		default:
+			nodes = append(nodes, node)
+		}
+		prev = class
+	}
+	return tex.HListOf(nodes, true)
+}
+
+// space returns a space of em ems, as mtex makes them.
+func (b texBuilder) space(st texState, em float64) *tex.Kern {
+	fnt := st.Font
+	fnt.Name = "it"
+	fnt.Type = "it"
+	m := st.Backend().Metrics("m", fnt, st.DPI, true)
+	return tex.NewKern(m.Advance * em)
+}
+
+// thickness returns the thickness of rules.
+func (b texBuilder) thickness(st texState) float64 {
+	return st.Backend().UnderlineThickness(st.Font, st.DPI)
+}
+
+// node returns the box of the node n and its math class.
+func (b texBuilder) node(n texNode, st texState) (tex.Node, texAtom) {
+	switch n := n.(type) {
+	case texChar:
+		return b.char(n.r, st)
+	case *texSym:
+		return b.sym(n.name, st)
+	case texGroup:
+		return b.hlist(n.list, st), ordAtom
+	case texMath:
+		st.math = true
+		st.Font.Type = "it"
+		st.style = textStyle
+		return b.hlist(n.list, st), ordAtom
+	case texSpace:
+		return b.space(st, n.em), ordAtom
+	case *texCmd:
+		return b.cmd(n, st)
+	case *texScripts:
+		return b.scripts(n, st)
+	case texLeftRight:
+		body := b.hlist(n.list, st)
+		return b.delimited(texDelims[n.left], body, texDelims[n.right], st), ordAtom
+	case texEnv:
+		return b.env(n, st), ordAtom
+	}
+	panic("unreachable")
+}
+
+// char returns the box of the character r.
+func (b texBuilder) char(r rune, st texState) (tex.Node, texAtom) {
+	if !st.math {
+		if st.Font.Type == "cal" {
+			st.Font.Type = "rm"
+		}
+		return tex.NewChar(string(r), st.State, false), ordAtom
+	}
+	if r == '\'' {
+		return tex.NewChar(`\prime`, st.State, true), ordAtom
+	}
+	if st.Font.Type == "cal" {
+		st.Font.Type = "it"
+		if 'A' <= r && r <= 'Z' && st.Backend().(*latexBackend).has("rm", texScriptLetter(r)) {
+			st.Font.Type = "rm"
+			r = texScriptLetter(r)
+		}
+	}
+	sym := string(r)
+	return tex.NewChar(sym, st.State, true), texClass(sym)
+}
+
+// sym returns the box of a symbol or function command.
+func (b texBuilder) sym(name string, st texState) (tex.Node, texAtom) {
+	if texFunction(name) {
+		st.Font.Type = "rm"
+		var nodes []tex.Node
+		for _, r := range name[1:] {
+			nodes = append(nodes, tex.NewChar(string(r), st.State, true))
+		}
+		return tex.HListOf(nodes, true), opAtom
+	}
+	sym, _ := texSymbol(name)
+	ch := tex.NewChar(sym, st.State, true)
+	class := texClass(name)
+	if class == opAtom && st.style == displayStyle {
+		ch.Grow()
+	}
+	return ch, class
+}
+
+// cmd returns the box of a command with arguments.
+func (b texBuilder) cmd(n *texCmd, st texState) (tex.Node, texAtom) {
+	if f, ok := texFontCommands[n.name]; ok {
+		st.Font.Type = f.font
+		st.math = st.math && !f.text
+		class := ordAtom
+		if n.name == `\operatorname` {
+			class = opAtom
+		}
+		return b.hlist(n.args[0], st), class
+	}
+	if f, ok := texFractions[n.name]; ok {
+		return b.frac(n, f, st), ordAtom
+	}
+	if a, ok := texAccents[n.name]; ok {
+		return b.accent(a, b.hlist(n.args[0], st), st), ordAtom
+	}
+	switch n.name {
+	case `\sqrt`:
+		return b.sqrt(n, st), ordAtom
+	case `\overline`:
+		return b.overline(b.hlist(n.args[0], st), st), ordAtom
+	case `\underline`:
+		return b.underline(b.hlist(n.args[0], st), st), ordAtom
+	case `\overset`, `\stackrel`:
+		return b.stack(n.args[0], n.args[1], nil, st), relAtom
+	case `\underset`:
+		return b.stack(nil, n.args[1], n.args[0], st), relAtom
+	}
+	panic("unreachable")
+}
+
+// frac returns the box of a fraction, laid out as mtex does.
+func (b texBuilder) frac(n *texCmd, f texFraction, st texState) tex.Node {
+	sty := st.style
+	if f.fixed {
+		sty = f.style
+	}
+	sub := st
+	sub.style = sty.frac()
+	num := b.hlist(n.args[0], sub)
+	den := b.hlist(n.args[1], sub)
+	resize(num, st.style, sub.style)
+	resize(den, st.style, sub.style)
+
+	var (
+		t     = b.thickness(st)
+		w     = math.Max(num.Width(), den.Width())
+		cnum  = centered(num, w)
+		cden  = centered(den, w)
+		rule  tex.Node
+		parts []tex.Node
+	)
+	switch {
+	case f.binom:
+		rule = tex.NewKern(0)
+	default:
+		rule = tex.HRule(st.State, t)
+	}
+	parts = append(parts, cnum, tex.VBox(0, 2*t), rule, tex.VBox(0, 2*t), cden)
+	vlist := tex.VListOf(parts)
+
+	// Center the fraction on the math axis.
+	vlist.SetShift(cden.Height() - (b.axis(st) - 3*t))
+	box := tex.HListOf([]tex.Node{vlist, tex.HBox(2 * t)}, true)
+	if f.binom {
+		box = b.delimited("(", box, ")", st)
+	}
+	return box
+}
+
+// xheight returns the height of the letter x.
+func (b texBuilder) xheight(st texState) float64 {
+	fnt := st.Font
+	fnt.Type = "it"
+	return st.Backend().Metrics("x", fnt, st.DPI, true).Iceberg
+}
+
+// axis returns the height of the math axis, the middle of
+// the equal sign.
+func (b texBuilder) axis(st texState) float64 {
+	fnt := st.Font
+	fnt.Type = "it"
+	m := st.Backend().Metrics("=", fnt, st.DPI, true)
+	return (m.YMax + m.YMin) / 2
+}
+
+// centered returns the node n centered in a box of width w.
+func centered(n tex.Node, w float64) *tex.HList {
+	box := tex.HCentered([]tex.Node{n})
+	box.HPack(w, false)
+	return box
+}
+
+// sqrt returns the box of a root, laid out as mtex does.
+func (b texBuilder) sqrt(n *texCmd, st texState) tex.Node {
+	var (
+		t    = b.thickness(st)
+		body = b.hlist(n.args[0], st)
+	)
+
+	// Make room above the body for the bar.
+	height := body.Height() - body.Shift() + 5*t
+	depth := body.Depth() + body.Shift()
+	check := tex.AutoHeightChar(`\__sqrt__`, height, depth, st.State, 0)
+	height = check.Height() - check.Shift()
+	depth = check.Depth() + check.Shift()
+
+	padded := tex.HListOf([]tex.Node{tex.HBox(2 * t), body, tex.HBox(2 * t)}, true)
+	rhs := tex.VListOf([]tex.Node{tex.HRule(st.State, -1), tex.NewGlue("fill"), padded})
+	rhs.VPack(height+(st.Font.Size*st.DPI)/(100*12), false, depth)
+
+	var root tex.Node = tex.HBox(check.Width() * 0.5)
+	if n.opt != nil {
+		sub := st
+		sub.style = scriptScriptStyle
+		root = b.hlist(n.opt, sub)
+		root.Shrink()
+		root.Shrink()
+	}
+	vl := tex.VListOf([]tex.Node{tex.HListOf([]tex.Node{root}, true)})
+	vl.SetShift(-height * 0.6)
+
+	return tex.HListOf([]tex.Node{vl, tex.NewKern(-check.Width() * 0.5), check, rhs}, true)
+}
+
+// overline returns the body with a bar above it, as mtex lays it out.
+func (b texBuilder) overline(body *tex.HList, st texState) tex.Node {
+	t := b.thickness(st)
+	height := body.Height() - body.Shift() + 3*t
+	depth := body.Depth() + body.Shift()
+	rhs := tex.VListOf([]tex.Node{
+		tex.HRule(st.State, -1),
+		tex.NewGlue("fill"),
+		tex.HListOf([]tex.Node{body}, true),
+	})
+	rhs.VPack(height+(st.Font.Size*st.DPI)/(100*12), false, depth)
+	return tex.HListOf([]tex.Node{rhs}, true)
+}
+
+// underline returns the body with a bar below it.
+func (b texBuilder) underline(body *tex.HList, st texState) tex.Node {
+	t := b.thickness(st)
+	vl := tex.VListOf([]tex.Node{body, tex.NewKern(2 * t), tex.HRule(st.State, t)})
+	// Lower the list to draw the body on the baseline.
+	vl.SetShift(body.Depth() + 2*t + t/2)
+	return tex.HListOf([]tex.Node{vl}, true)
+}
+
+// accent returns the body with an accent above it.
+func (b texBuilder) accent(a texAccent, body *tex.HList, st texState) tex.Node {
+	sty := st.State
+	sty.Font.Type = "rm"
+	acc := tex.NewAccent(a.glyph, sty, true)
+	if a.wide && body.Width() > acc.Width() {
+		// Stretch the accent over the body, keeping it
+		// from growing too tall.
+		sty.Font.Size *= math.Min(body.Width()/acc.Width(), 2.5)
+		acc = tex.NewAccent(a.glyph, sty, true)
+	}
+	if a.small {
+		acc.Shrink()
+	}
+	w := math.Max(body.Width(), acc.Width())
+	return tex.VListOf([]tex.Node{
+		centered(acc, w),
+		tex.NewKern(b.thickness(st) * 2),
+		centered(body, w),
+	})
+}
+
+// stack returns the base with math stacked above and below it.
+// Absent math is nil.
+func (b texBuilder) stack(over, base, under texList, st texState) tex.Node {
+	var (
+		t   = b.thickness(st)
+		sub = st
+	)
+	sub.style = st.style.script()
+	mid, _ := b.node(texGroup{base}, st)
+	w := mid.Width()
+	var above, below *tex.HList
+	if over != nil {
+		above = b.hlist(over, sub)
+		resize(above, st.style, sub.style)
+		w = math.Max(w, above.Width())
+	}
+	if under != nil {
+		below = b.hlist(under, sub)
+		resize(below, st.style, sub.style)
+		w = math.Max(w, below.Width())
+	}
+	return b.limits(mid, above, below, w, 2*t)
+}
+
+// limits returns the nucleus with the math above and below it
+// centered in a box of width w, separated by gap. Absent math
+// is nil.
+func (b texBuilder) limits(nucleus tex.Node, above, below *tex.HList, w, gap float64) tex.Node {
+	var nodes []tex.Node
+	if above != nil {
+		nodes = append(nodes, centered(above, w), tex.NewKern(gap))
+	}
+	nodes = append(nodes, centered(nucleus, w))
+	if below == nil {
+		return tex.VListOf(nodes)
+	}
+	nodes = append(nodes, tex.NewKern(gap), centered(below, w))
+	vl := tex.VListOf(nodes)
+	// Lower the list to draw the nucleus on the baseline.
+	vl.SetShift(nucleus.Depth() + gap + below.Height())
+	return vl
+}
+
+// scripts returns the box of a nucleus with scripts, with the
+// font constants of go-latex.
+func (b texBuilder) scripts(n *texScripts, st texState) (tex.Node, texAtom) {
+	var (
+		nucleus tex.Node = tex.HBox(0)
+		class            = ordAtom
+	)
+	if n.base != nil {
+		nucleus, class = b.node(n.base, st)
+	}
+
+	sub := st
+	sub.style = st.style.script()
+	var sup, down *tex.HList
+	if n.sup != nil {
+		sup = b.hlist(n.sup, sub)
+		resize(sup, st.style, sub.style)
+	}
+	if n.sub != nil {
+		down = b.hlist(n.sub, sub)
+		resize(down, st.style, sub.style)
+	}
+
+	if sym, ok := n.base.(*texSym); ok && texOperator(sym.name) {
+		limits := sym.limits > 0 || sym.limits == 0 && st.style == displayStyle && texDisplayLimits(sym.name)
+		if limits {
+			w := nucleus.Width()
+			if sup != nil {
+				w = math.Max(w, sup.Width())
+			}
+			if down != nil {
+				w = math.Max(w, down.Width())
+			}
+			return b.limits(nucleus, sup, down, w, 3*b.thickness(st)), class
+		}
+	}
+
+	var (
+		fc = tex.DefaultFontConstants
+		xh = b.xheight(st)
+		t  = b.thickness(st)
+
+		shiftUp, shiftDown float64
+	)
+	switch n.base.(type) {
+	case texChar, *texSym, nil:
+	default:
+		// Scripts of composite nuclei are placed
+		// relative to their extent.
+		shiftUp = nucleus.Height() - fc.SubDrop*xh
+		shiftDown = nucleus.Depth() + fc.SubDrop*xh
+	}
+
+	var scripts *tex.VList
+	switch {
+	case sup == nil:
+		shiftDown = math.Max(shiftDown, math.Max(fc.Sub1*xh, down.Height()-0.8*xh))
+		scripts = tex.VListOf([]tex.Node{down})
+		scripts.SetShift(shiftDown)
+	case down == nil:
+		shiftUp = math.Max(shiftUp, math.Max(fc.Sup1*xh, sup.Depth()+0.25*xh))
+		scripts = tex.VListOf([]tex.Node{sup})
+		scripts.SetShift(-shiftUp)
+	default:
+		shiftUp = math.Max(shiftUp, math.Max(fc.Sup1*xh, sup.Depth()+0.25*xh))
+		shiftDown = math.Max(shiftDown, fc.Sub2*xh)
+		gap := (shiftUp - sup.Depth()) - (down.Height() - shiftDown)
+		if clr := 4*t - gap; clr > 0 {
+			shiftUp += clr
+			gap += clr
+		}
+		scripts = tex.VListOf([]tex.Node{sup, tex.NewKern(gap), down})
+		scripts.SetShift(shiftDown)
+	}
+	return tex.HListOf([]tex.Node{nucleus, scripts, tex.HBox(fc.ScriptSpace * xh)}, true), class
+}
+
+// delimited returns the body between the left and right go-latex
+// delimiters, grown to its size as mtex grows them.
+func (b texBuilder) delimited(left string, body tex.Node, right string, st texState) *tex.HList {
+	var (
+		h = body.Height()
+		d = body.Depth()
+
+		nodes []tex.Node
+	)
+	delim := func(sym string) {
+		if sym == "." {
+			return
+		}
+		// Delimiters are not shrunk to small bodies.
+		factor := 0.0
+		if ch := tex.NewChar(sym, st.State, true); h+d <= ch.Height()+ch.Depth() {
+			factor = 1
+		}
+		nodes = append(nodes, tex.AutoHeightChar(sym, h, d, st.State, factor))
+	}
+	delim(left)
+	nodes = append(nodes, body)
+	delim(right)
+	return tex.HListOf(nodes, true)
+}
+
+// env returns the box of a matrix environment, centered on the
+// math axis.
+func (b texBuilder) env(n texEnv, st texState) tex.Node {
+	var (
+		cols  int
+		cells = make([][]*tex.HList, len(n.rows))
+	)
+	for i, row := range n.rows {
+		for _, cell := range row {
+			cells[i] = append(cells[i], b.hlist(cell, st))
+		}
+		if len(row) > cols {
+			cols = len(row)
+		}
+	}
+	widths := make([]float64, cols)
+	for _, row := range cells {
+		for j, cell := range row {
+			widths[j] = math.Max(widths[j], cell.Width())
+		}
+	}
+
+	var (
+		quad  = b.space(st, 1)
+		skip  = 1.2 * st.Font.Size * st.DPI / latexDPI
+		rows  []tex.Node
+		prevD float64
+	)
+	for i, row := range cells {
+		var nodes []tex.Node
+		for j, w := range widths {
+			if j > 0 {
+				nodes = append(nodes, tex.HBox(quad.Width()))
+			}
+			switch {
+			case j >= len(row):
+				nodes = append(nodes, tex.HBox(w))
+			case n.name == "cases":
+				cell := tex.HListOf([]tex.Node{row[j], tex.NewGlue("fill")}, false)
+				cell.HPack(w, false)
+				nodes = append(nodes, cell)
+			default:
+				nodes = append(nodes, centered(row[j], w))
+			}
+		}
+		line := tex.HListOf(nodes, false)
+		if i > 0 {
+			// Keep the baselines of the rows a line apart.
+			gap := math.Max(skip-prevD-line.Height(), 2*b.thickness(st))
+			rows = append(rows, tex.NewKern(gap))
+		}
+		rows = append(rows, line)
+		prevD = line.Depth()
+	}
+	vl := tex.VListOf(rows)
+	vl.SetShift(vl.Height() - (vl.Height()+vl.Depth())/2 - b.axis(st))
+	body := tex.HListOf([]tex.Node{vl}, true)
+
+	delims := texEnvs[n.name]
+	return b.delimited(texDelims[delims[0]], body, texDelims[delims[1]], st)
+}
@@ -0,0 +1,565 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package text
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// texNode is a node of a parsed LaTeX text.
+type texNode interface {
+	texNode()
+}
+
+// texList is a list of nodes.
+type texList []texNode
+
+// texChar is a character.
+type texChar struct {
+	r rune
+}
+
+// texSym is a math symbol or function, such as \alpha,
+// \sum or \sin.
+type texSym struct {
+	name string
+
+	// limits is +1 for \limits, -1 for \nolimits,
+	// and 0 for the default placement of the limits
+	// of an operator.
+	limits int
+}
+
+// texGroup is a group of nodes, in braces.
+type texGroup struct {
+	list texList
+}
+
+// texMath is math within text, between dollar signs.
+type texMath struct {
+	list texList
+}
+
+// texCmd is a command with arguments, such as \frac{a}{b}.
+type texCmd struct {
+	name string
+	opt  texList // opt is the optional argument, as in \sqrt[3]{x}.
+	args []texList
+
+	limits int
+}
+
+// texScripts is a nucleus with a superscript, a subscript
+// or both. Absent scripts are nil.
+type texScripts struct {
+	base     texNode
+	sup, sub texList
+	primed   bool
+}
+
+// texLeftRight is a list between \left and \right delimiters.
+type texLeftRight struct {
+	left, right string
+	list        texList
+}
+
+// texEnv is an environment, such as a matrix.
+type texEnv struct {
+	name string
+	rows [][]texList
+}
+
+// texSpace is an explicit space, in ems.
+type texSpace struct {
+	em float64
+}
+
+// texStyleCmd sets the math style of the following nodes.
+type texStyleCmd struct {
+	style mathStyle
+}
+
+func (texChar) texNode()      {}
+func (*texSym) texNode()      {}
+func (texGroup) texNode()     {}
+func (texMath) texNode()      {}
+func (*texCmd) texNode()      {}
+func (*texScripts) texNode()  {}
+func (texLeftRight) texNode() {}
+func (texEnv) texNode()       {}
+func (texSpace) texNode()     {}
+func (texStyleCmd) texNode()  {}
+
+// texError is an error in a LaTeX text.
+type texError struct {
+	pos int // pos is the byte offset of the error.
+	msg string
+}
+
+func (e *texError) Error() string {
+	return fmt.Sprintf("%s at offset %d", e.msg, e.pos)
+}
+
+// parseLatex parses a line of LaTeX text.
+func parseLatex(txt string) (list texList, err error) {
+	p := texParser{src: txt}
+	defer func() {
+		e := recover()
+		if e == nil {
+			return
+		}
+		perr, ok := e.(*texError)
+		if !ok {
+			panic(e)
+		}
+		list, err = nil, perr
+	}()
+	list = p.list(false, 0)
+	if !p.eof() {
+		p.unexpected()
+	}
+	return list, nil
+}
+
+// texParser parses LaTeX text. Parse errors are
+// raised as panics of *texError values.
+type texParser struct {
+	src string
+	pos int
+}
+
+func (p *texParser) errorf(pos int, format string, args ...any) {
+	panic(&texError{pos: pos, msg: fmt.Sprintf(format, args...)})
+}
+
+func (p *texParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+// peek returns the rune at the current position.
+func (p *texParser) peek() rune {
+	r, _ := utf8.DecodeRuneInString(p.src[p.pos:])
+	return r
+}
+
+// next returns the rune at the current position and
+// advances past it.
+func (p *texParser) next() rune {
+	r, n := utf8.DecodeRuneInString(p.src[p.pos:])
+	p.pos += n
+	return r
+}
+
+// command returns the name of the command at the current
+// position, including its backslash, without advancing.
+func (p *texParser) command() string {
+	rest := p.src[p.pos:]
+	if !strings.HasPrefix(rest, `\`) {
+		return ""
+	}
+	n := 1
+	for n < len(rest) && isTexLetter(rest[n]) {
+		n++
+	}
+	if n == 1 && n < len(rest) {
+		_, sz := utf8.DecodeRuneInString(rest[n:])
+		n += sz
+	}
+	return rest[:n]
+}
+
+func isTexLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func (p *texParser) skipSpaces() {
+	for !p.eof() && unicode.IsSpace(p.peek()) {
+		p.next()
+	}
+}
+
+// unexpected reports the token at the current position,
+// which ends a list in a context it can not end it.
+func (p *texParser) unexpected() {
+	switch cmd := p.command(); {
+	case p.eof():
+		p.errorf(p.pos, "unexpected end of text")
+	case cmd == `\right`:
+		p.errorf(p.pos, `\right without \left`)
+	case cmd == `\end`:
+		p.errorf(p.pos, `\end without \begin`)
+	case cmd == `\\`:
+		p.errorf(p.pos, `misplaced \\`)
+	default:
+		p.errorf(p.pos, "unexpected %q", p.peek())
+	}
+}
+
+// list parses a list of nodes in math or text mode, until
+// the end of the text, or a token ending the list: a closing
+// brace or dollar sign, an alignment character, \right, \\,
+// \end, or the stop rune if not zero. The ending token is
+// not consumed.
+func (p *texParser) list(math bool, stop rune) texList {
+	var list texList
+	for !p.eof() {
+		r := p.peek()
+		switch {
+		case r == '}' || r == '&' || math && r == '$' || stop != 0 && r == stop:
+			return list
+		case r == '\\':
+			switch p.command() {
+			case `\right`, `\end`, `\\`:
+				return list
+			case `\limits`, `\nolimits`:
+				p.limits(list)
+				continue
+			}
+		case math && unicode.IsSpace(r):
+			p.next()
+			continue
+		case r == '%':
+			// Comments run to the end of the line.
+			p.pos = len(p.src)
+			continue
+		case math && (r == '^' || r == '_' || r == '\''):
+			list = p.script(list)
+			continue
+		}
+		if n := p.node(math); n != nil {
+			list = append(list, n)
+		}
+	}
+	return list
+}
+
+// limits parses \limits or \nolimits, setting the placement of
+// the limits of the operator ending the list.
+func (p *texParser) limits(list texList) {
+	pos := p.pos
+	cmd := p.command()
+	p.pos += len(cmd)
+	v := +1
+	if cmd == `\nolimits` {
+		v = -1
+	}
+	if len(list) > 0 {
+		switch n := list[len(list)-1].(type) {
+		case *texSym:
+			if texOperator(n.name) {
+				n.limits = v
+				return
+			}
+		case *texCmd:
+			if n.name == `\operatorname` {
+				n.limits = v
+				return
+			}
+		}
+	}
+	p.errorf(pos, "%s must follow a math operator", cmd)
+}
+
+// script parses a superscript, a subscript or a prime,
+// attaching it to the last node of the list.
+func (p *texParser) script(list texList) texList {
+	pos := p.pos
+	c := p.next()
+
+	var s *texScripts
+	if n := len(list); n > 0 {
+		s, _ = list[n-1].(*texScripts)
+	}
+	if s == nil {
+		s = &texScripts{}
+		if n := len(list); n > 0 {
+			switch list[n-1].(type) {
+			case texSpace, texStyleCmd:
+				// Scripts after spaces have an empty nucleus.
+			default:
+				s.base = list[n-1]
+				list = list[:n-1]
+			}
+		}
+		list = append(list, s)
+	}
+
+	switch c {
+	case '\'':
+		if s.sup != nil && !s.primed {
+			p.errorf(pos, "double superscript")
+		}
+		s.sup = append(s.sup, texChar{'\''})
+		s.primed = true
+	case '^':
+		if s.sup != nil && !s.primed {
+			p.errorf(pos, "double superscript")
+		}
+		s.sup = append(append(texList{}, s.sup...), p.arg("^", true)...)
+		s.primed = false
+	case '_':
+		if s.sub != nil {
+			p.errorf(pos, "double subscript")
+		}
+		s.sub = append(texList{}, p.arg("_", true)...)
+	}
+	return list
+}
+
+// arg parses the argument of a command: a group in braces,
+// or a single character or command.
+func (p *texParser) arg(cmd string, math bool) texList {
+	p.skipSpaces()
+	pos := p.pos
+	if p.eof() {
+		p.errorf(pos, "missing argument of %s", cmd)
+	}
+	switch r := p.peek(); {
+	case r == '{':
+		p.next()
+		list := p.list(math, 0)
+		p.closing(pos, '}')
+		return list
+	case r == '}' || r == '&' || r == '^' || r == '_' || r == '$':
+		p.errorf(pos, "missing argument of %s", cmd)
+	case r == '\\':
+		switch p.command() {
+		case `\right`, `\end`, `\\`, `\limits`, `\nolimits`:
+			p.errorf(pos, "missing argument of %s", cmd)
+		}
+	}
+	n := p.node(math)
+	if n == nil {
+		p.errorf(pos, "missing argument of %s", cmd)
+	}
+	return texList{n}
+}
+
+// closing consumes the closing rune of the construct
+// opened at pos.
+func (p *texParser) closing(pos int, r rune) {
+	switch {
+	case p.eof(), p.peek() == '$' && r != '$':
+		p.errorf(pos, "missing closing %q", r)
+	case p.peek() != r:
+		p.unexpected()
+	}
+	p.next()
+}
+
+// node parses a single node in math or text mode. It returns
+// nil for constructs without nodes, such as comments.
+func (p *texParser) node(math bool) texNode {
+	pos := p.pos
+	r := p.peek()
+	switch {
+	case r == '\\':
+		return p.cmd(math)
+	case r == '{':
+		p.next()
+		list := p.list(math, 0)
+		p.closing(pos, '}')
+		return texGroup{list}
+	case r == '$':
+		p.next()
+		list := p.list(true, 0)
+		p.closing(pos, '$')
+		return texMath{list}
+	case r == '^' || r == '_':
+		p.errorf(pos, "%c is only allowed in math mode", r)
+	case r == '#':
+		p.errorf(pos, "misplaced #")
+	case r == '~':
+		p.next()
+		return texChar{' '}
+	case unicode.IsSpace(r):
+		// Runs of spaces are a single space in text mode.
+		p.skipSpaces()
+		return texChar{' '}
+	}
+	p.next()
+	return texChar{r}
+}
+
+// cmd parses a command.
+func (p *texParser) cmd(math bool) texNode {
+	pos := p.pos
+	name := p.command()
+	if name == `\` {
+		p.errorf(pos, `missing command name after \`)
+	}
+	p.pos += len(name)
+	if isTexLetter(name[len(name)-1]) {
+		// Spaces after command names are ignored.
+		p.skipSpaces()
+	}
+
+	// Commands allowed in text and math modes.
+	switch name {
+	case `\ `:
+		return texChar{' '}
+	case `\{`, `\}`, `\$`, `\%`, `\&`, `\#`, `\_`:
+		if math {
+			return &texSym{name: name}
+		}
+		return texChar{rune(name[1])}
+	case `\textbackslash`:
+		return texChar{'\\'}
+	case `\ldots`, `\dots`:
+		if math {
+			return &texSym{name: name}
+		}
+		return texChar{'…'}
+	}
+	if em, ok := texSpaces[name]; ok {
+		return texSpace{em}
+	}
+	if fc, ok := texFontCommands[name]; ok && (math || fc.text) {
+		return &texCmd{name: name, args: []texList{p.arg(name, math && !fc.text)}}
+	}
+
+	_, isAccent := texAccents[name]
+	_, isFrac := texFractions[name]
+	_, isStyle := texStyles[name]
+	_, isFont := texFontCommands[name]
+	isSym := false
+	switch {
+	case isAccent || isFrac || isStyle || isFont:
+	case name == `\sqrt`, name == `\overline`, name == `\underline`,
+		name == `\overset`, name == `\underset`, name == `\stackrel`,
+		name == `\left`, name == `\begin`:
+	default:
+		_, isSym = texSymbol(name)
+		if !isSym && !texFunction(name) {
+			p.errorf(pos, "unknown command %s", name)
+		}
+		isSym = true
+	}
+	if !math {
+		p.errorf(pos, "%s is only allowed in math mode", name)
+	}
+
+	switch {
+	case isSym:
+		return &texSym{name: name}
+	case isStyle:
+		return texStyleCmd{texStyles[name]}
+	case isAccent, name == `\overline`, name == `\underline`:
+		return &texCmd{name: name, args: []texList{p.arg(name, true)}}
+	case isFrac, name == `\overset`, name == `\underset`, name == `\stackrel`:
+		a := p.arg(name, true)
+		b := p.arg(name, true)
+		return &texCmd{name: name, args: []texList{a, b}}
+	case name == `\sqrt`:
+		cmd := &texCmd{name: name}
+		p.skipSpaces()
+		if !p.eof() && p.peek() == '[' {
+			opos := p.pos
+			p.next()
+			cmd.opt = p.list(true, ']')
+			p.closing(opos, ']')
+		}
+		cmd.args = []texList{p.arg(name, true)}
+		return cmd
+	case name == `\left`:
+		return p.leftRight(pos)
+	case name == `\begin`:
+		return p.env(pos)
+	}
+	panic("unreachable")
+}
+
+// delim parses the delimiter following \left or \right.
+func (p *texParser) delim(cmd string) string {
+	p.skipSpaces()
+	pos := p.pos
+	if p.eof() {
+		p.errorf(pos, "missing delimiter after %s", cmd)
+	}
+	var d string
+	if p.peek() == '\\' {
+		d = p.command()
+	} else {
+		_, n := utf8.DecodeRuneInString(p.src[p.pos:])
+		d = p.src[p.pos : p.pos+n]
+	}
+	if _, ok := texDelims[d]; !ok {
+		p.errorf(pos, "invalid delimiter %s after %s", d, cmd)
+	}
+	p.pos += len(d)
+	return d
+}
+
+// leftRight parses a list between \left and \right delimiters,
+// the \left command at pos being consumed.
+func (p *texParser) leftRight(pos int) texNode {
+	var n texLeftRight
+	n.left = p.delim(`\left`)
+	n.list = p.list(true, 0)
+	if p.command() != `\right` {
+		if p.eof() || p.peek() == '$' {
+			p.errorf(pos, `missing \right`)
+		}
+		p.unexpected()
+	}
+	p.pos += len(`\right`)
+	n.right = p.delim(`\right`)
+	return n
+}
+
+// envName parses the name of an environment, in braces.
+func (p *texParser) envName(cmd string) string {
+	p.skipSpaces()
+	pos := p.pos
+	if p.eof() || p.peek() != '{' {
+		p.errorf(pos, "missing environment name after %s", cmd)
+	}
+	end := strings.IndexByte(p.src[pos:], '}')
+	if end < 0 {
+		p.errorf(pos, "missing closing '}'")
+	}
+	p.pos += end + 1
+	return strings.TrimSpace(p.src[pos+1 : pos+end])
+}
+
+// env parses an environment, the \begin command at pos
+// being consumed.
+func (p *texParser) env(pos int) texNode {
+	env := texEnv{name: p.envName(`\begin`)}
+	if _, ok := texEnvs[env.name]; !ok {
+		p.errorf(pos, "unknown environment %s", env.name)
+	}
+	row := []texList{}
+	for {
+		cell := p.list(true, 0)
+		row = append(row, cell)
+		switch {
+		case !p.eof() && p.peek() == '&':
+			p.next()
+		case p.command() == `\\`:
+			p.pos += len(`\\`)
+			env.rows = append(env.rows, row)
+			row = []texList{}
+		case p.command() == `\end`:
+			epos := p.pos
+			p.pos += len(`\end`)
+			if name := p.envName(`\end`); name != env.name {
+				p.errorf(epos, `\begin{%s} ended by \end{%s}`, env.name, name)
+			}
+			// A final \\ does not start a new row.
+			if len(row) > 1 || len(row[0]) > 0 || len(env.rows) == 0 {
+				env.rows = append(env.rows, row)
+			}
+			return env
+		case p.eof() || p.peek() == '$':
+			p.errorf(pos, `missing \end{%s}`, env.name)
+		default:
+			p.unexpected()
+		}
+	}
+}
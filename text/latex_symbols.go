@@ -0,0 +1,279 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package text
+
+import (
+	"sync"
+
+	"codeberg.org/go-latex/latex/drawtex"
+	lfont "codeberg.org/go-latex/latex/font"
+	"codeberg.org/go-latex/latex/font/ttf"
+	"codeberg.org/go-latex/latex/mtex/symbols"
+)
+
+// texAtom is the class of a math atom, which determines
+// the spacing between consecutive atoms.
+type texAtom uint8
+
+const (
+	ordAtom texAtom = iota
+	opAtom
+	binAtom
+	relAtom
+	openAtom
+	punctAtom
+)
+
+// texClass returns the class of a math symbol, a TeX
+// symbol name or a character.
+func texClass(sym string) texAtom {
+	switch {
+	case symbols.BinaryOperators.Has(sym):
+		return binAtom
+	case symbols.RelationSymbols.Has(sym), symbols.ArrowSymbols.Has(sym):
+		return relAtom
+	case symbols.LeftDelim.Has(sym):
+		return openAtom
+	case sym == ",", sym == ";":
+		return punctAtom
+	case texOperator(sym):
+		return opAtom
+	}
+	return ordAtom
+}
+
+// texAliases are the names of symbols that go-latex
+// knows under another name.
+var texAliases = map[string]string{
+	`\le`:     `\leq`,
+	`\ge`:     `\geq`,
+	`\gets`:   `\leftarrow`,
+	`\iff`:    `\Longleftrightarrow`,
+	`\land`:   `\wedge`,
+	`\lor`:    `\vee`,
+	`\lnot`:   `\neg`,
+	`\owns`:   `\ni`,
+	`\lvert`:  `|`,
+	`\rvert`:  `|`,
+	`\lVert`:  `\Vert`,
+	`\rVert`:  `\Vert`,
+	`\lbrack`: `[`,
+	`\rbrack`: `]`,
+	`\&`:      `&`,
+}
+
+// texKnown caches whether go-latex knows symbols, by name.
+var texKnown sync.Map
+
+// texSymbol returns the go-latex symbol drawn for the named
+// symbol command, and whether go-latex knows the symbol.
+func texSymbol(name string) (string, bool) {
+	if sym, ok := texAliases[name]; ok {
+		return sym, true
+	}
+	if ok, done := texKnown.Load(name); done {
+		return name, ok.(bool)
+	}
+	ok := func() (ok bool) {
+		// The TrueType backend panics on unknown symbols.
+		defer func() {
+			if recover() != nil {
+				ok = false
+			}
+		}()
+		be := ttf.New(drawtex.New())
+		be.Metrics(name, lfont.Font{Type: "rm", Size: 12}, latexDPI, true)
+		return true
+	}()
+	texKnown.Store(name, ok)
+	return name, ok
+}
+
+// texFunction returns whether the named command is a math
+// function, drawn as an upright word, such as \sin.
+func texFunction(name string) bool {
+	return len(name) > 1 && symbols.FunctionNames.Has(name[1:])
+}
+
+// texOperator returns whether the named symbol is a big operator
+// or a function, whose limits can be drawn above and below it.
+func texOperator(name string) bool {
+	switch name {
+	case `\iint`, `\iiint`, `\oint`:
+		return true
+	}
+	return symbols.OverUnderSymbols.Has(name) ||
+		symbols.DropSubSymbols.Has(name) ||
+		texFunction(name)
+}
+
+// texDisplayLimits returns whether the limits of the named
+// operator are drawn above and below it in display style.
+func texDisplayLimits(name string) bool {
+	return symbols.OverUnderSymbols.Has(name) ||
+		len(name) > 1 && symbols.OverUnderFunctions.Has(name[1:])
+}
+
+// texDelims are the delimiters of \left and \right, and the
+// go-latex symbols drawing them. The null delimiter "." is
+// not drawn.
+var texDelims = map[string]string{
+	".":            ".",
+	"(":            "(",
+	")":            ")",
+	"[":            "[",
+	"]":            "]",
+	"|":            "|",
+	"/":            "/",
+	"<":            `\langle`,
+	">":            `\rangle`,
+	`\{`:           `\{`,
+	`\}`:           `\}`,
+	`\lbrace`:      `\{`,
+	`\rbrace`:      `\}`,
+	`\lbrack`:      "[",
+	`\rbrack`:      "]",
+	`\langle`:      `\langle`,
+	`\rangle`:      `\rangle`,
+	`\lfloor`:      `\lfloor`,
+	`\rfloor`:      `\rfloor`,
+	`\lceil`:       `\lceil`,
+	`\rceil`:       `\rceil`,
+	`\vert`:        "|",
+	`\lvert`:       "|",
+	`\rvert`:       "|",
+	`\|`:           `\Vert`,
+	`\Vert`:        `\Vert`,
+	`\lVert`:       `\Vert`,
+	`\rVert`:       `\Vert`,
+	`\backslash`:   `\backslash`,
+	`\uparrow`:     `\uparrow`,
+	`\downarrow`:   `\downarrow`,
+	`\updownarrow`: `\updownarrow`,
+}
+
+// texAccents are the accents, by command name.
+var texAccents = map[string]texAccent{
+	`\hat`:       {glyph: "ˆ"},
+	`\check`:     {glyph: "ˇ"},
+	`\tilde`:     {glyph: "˜"},
+	`\acute`:     {glyph: "´"},
+	`\grave`:     {glyph: "`"},
+	`\dot`:       {glyph: "˙"},
+	`\ddot`:      {glyph: "¨"},
+	`\breve`:     {glyph: "˘"},
+	`\bar`:       {glyph: "¯"},
+	`\vec`:       {glyph: "→", small: true},
+	`\mathring`:  {glyph: "˚"},
+	`\widehat`:   {glyph: "ˆ", wide: true},
+	`\widetilde`: {glyph: "˜", wide: true},
+}
+
+type texAccent struct {
+	glyph string
+	small bool // whether the glyph is drawn at script size.
+	wide  bool // whether the glyph is stretched over its argument.
+}
+
+// texSpaces are the widths of the explicit spaces, in ems.
+var texSpaces = map[string]float64{
+	`\,`:         3.0 / 18,
+	`\thinspace`: 3.0 / 18,
+	`\:`:         4.0 / 18,
+	`\>`:         4.0 / 18,
+	`\;`:         5.0 / 18,
+	`\!`:         -3.0 / 18,
+	`\enspace`:   0.5,
+	`\quad`:      1,
+	`\qquad`:     2,
+}
+
+// texFontCommands are the commands drawing their argument
+// with a go-latex font type, and whether their argument is
+// text. The "cal" font type draws capital letters with
+// script glyphs.
+var texFontCommands = map[string]texFontCommand{
+	`\mathrm`:       {font: "rm"},
+	`\mathit`:       {font: "it"},
+	`\mathnormal`:   {font: "it"},
+	`\mathbf`:       {font: "bf"},
+	`\boldsymbol`:   {font: "bfit"},
+	`\mathsf`:       {font: "sf"},
+	`\mathtt`:       {font: "tt"},
+	`\mathcal`:      {font: "cal"},
+	`\operatorname`: {font: "rm"},
+	`\text`:         {font: "rm", text: true},
+	`\textrm`:       {font: "rm", text: true},
+	`\textup`:       {font: "rm", text: true},
+	`\mbox`:         {font: "rm", text: true},
+	`\textit`:       {font: "it", text: true},
+	`\emph`:         {font: "it", text: true},
+	`\textbf`:       {font: "bf", text: true},
+	`\textsf`:       {font: "sf", text: true},
+	`\texttt`:       {font: "tt", text: true},
+}
+
+type texFontCommand struct {
+	font string
+	text bool
+}
+
+// texScriptLetter returns the script glyph of the capital letter r.
+func texScriptLetter(r rune) rune {
+	switch r {
+	case 'B':
+		return 'ℬ'
+	case 'E':
+		return 'ℰ'
+	case 'F':
+		return 'ℱ'
+	case 'H':
+		return 'ℋ'
+	case 'I':
+		return 'ℐ'
+	case 'L':
+		return 'ℒ'
+	case 'M':
+		return 'ℳ'
+	case 'R':
+		return 'ℛ'
+	}
+	return '𝒜' + r - 'A'
+}
+
+// texFractions are the fraction commands.
+var texFractions = map[string]texFraction{
+	`\frac`:   {},
+	`\dfrac`:  {style: displayStyle, fixed: true},
+	`\tfrac`:  {style: textStyle, fixed: true},
+	`\binom`:  {binom: true},
+	`\dbinom`: {style: displayStyle, fixed: true, binom: true},
+	`\tbinom`: {style: textStyle, fixed: true, binom: true},
+}
+
+type texFraction struct {
+	style mathStyle
+	fixed bool // whether the style of the fraction is fixed.
+	binom bool // whether the fraction is a binomial coefficient.
+}
+
+// texEnvs are the environments, with their delimiters.
+var texEnvs = map[string][2]string{
+	"matrix":  {".", "."},
+	"pmatrix": {"(", ")"},
+	"bmatrix": {"[", "]"},
+	"Bmatrix": {`\{`, `\}`},
+	"vmatrix": {"|", "|"},
+	"Vmatrix": {`\|`, `\|`},
+	"cases":   {`\{`, "."},
+}
+
+// texStyles are the commands setting the math style.
+var texStyles = map[string]mathStyle{
+	`\displaystyle`:      displayStyle,
+	`\textstyle`:         textStyle,
+	`\scriptstyle`:       scriptStyle,
+	`\scriptscriptstyle`: scriptScriptStyle,
+}
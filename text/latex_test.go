@@ -5,6 +5,8 @@
 package text_test
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 
 	stdfnt "golang.org/x/image/font"
@@ -12,6 +14,7 @@ import (
 	"gonum.org/v1/plot/font/liberation"
 	"gonum.org/v1/plot/text"
 	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/recorder"
 )
 
 func TestLatexText(t *testing.T) {
@@ -141,3 +144,153 @@ func TestLatexText(t *testing.T) {
 		})
 	}
 }
+
+func TestLatexValidate(t *testing.T) {
+	hdlr := text.Latex{Fonts: font.NewCache(liberation.Collection())}
+	for _, tc := range []struct {
+		txt string
+		err string
+	}{
+		{txt: "plain text"},
+		{txt: `$\frac{a}{b} + \sqrt[3]{x^2_i}$`},
+		{txt: `$\hat{x} \bar{y} \vec{v} \widetilde{xyz} \overline{AB}$`},
+		{txt: `$\mathrm{d}x \mathbf{F} \mathcal{L} \boldsymbol{\beta} \text{if } x$`},
+		{txt: `$\sum\limits_{i=0}^{n} x_i \int_0^\infty f \lim_{x\to 0} \operatorname{erf}$`},
+		{txt: `$\left(\frac{a}{b}\right) \left. x \right|_0^1$`},
+		{txt: `$\begin{pmatrix} a & b \\ c & d \end{pmatrix}$`},
+		{txt: `$f'(x) = x''^2$ and \textbf{bold} 50\% ~ \$5`},
+		{txt: "two\nlines"},
+		{txt: `$x`, err: "missing closing '$' at offset 0"},
+		{txt: `$\frac{a}{$`, err: "missing closing '}' at offset 9"},
+		{txt: `$\frac{a}$`, err: `missing argument of \frac at offset 9`},
+		{txt: `$\foo$`, err: `unknown command \foo at offset 1`},
+		{txt: `x^2`, err: "^ is only allowed in math mode at offset 1"},
+		{txt: `\alpha`, err: `\alpha is only allowed in math mode at offset 0`},
+		{txt: `$x^2^3$`, err: "double superscript at offset 4"},
+		{txt: `$x_1_2$`, err: "double subscript at offset 4"},
+		{txt: `$\left( x$`, err: `missing \right at offset 1`},
+		{txt: `$x \right)$`, err: `\right without \left at offset 3`},
+		{txt: `$\left\foo x \right)$`, err: `invalid delimiter \foo after \left at offset 6`},
+		{txt: `$\begin{foo} x \end{foo}$`, err: "unknown environment foo at offset 1"},
+		{txt: `$\begin{matrix} x \end{pmatrix}$`, err: `\begin{matrix} ended by \end{pmatrix} at offset 18`},
+		{txt: `$\begin{matrix} x$`, err: `missing \end{matrix} at offset 1`},
+		{txt: `$a & b$`, err: `unexpected '&' at offset 3`},
+		{txt: `$a \\ b$`, err: `misplaced \\ at offset 3`},
+		{txt: `$x\limits$`, err: `\limits must follow a math operator at offset 2`},
+		{txt: `a}`, err: "unexpected '}' at offset 1"},
+		{txt: "ok\n$x", err: `"$x": missing closing '$' at offset 0`},
+	} {
+		t.Run(tc.txt, func(t *testing.T) {
+			err := hdlr.Validate(tc.txt)
+			switch {
+			case tc.err == "" && err != nil:
+				t.Fatalf("unexpected error: %+v", err)
+			case tc.err != "" && err == nil:
+				t.Fatalf("expected an error")
+			case tc.err != "" && !strings.HasSuffix(err.Error(), tc.err):
+				t.Fatalf("invalid error:\ngot= %q\nwant=%q", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestLatexInvalid(t *testing.T) {
+	var (
+		hdlr = text.Latex{Fonts: font.NewCache(liberation.Collection())}
+		fnt  = font.Font{Variant: "Serif", Size: 12}
+		sty  = text.Style{Font: fnt, Handler: hdlr}
+	)
+
+	// Invalid text is drawn verbatim, as its escaped text is.
+	w1, h1, d1 := hdlr.Box(`$\frac{a}{$`, fnt)
+	w2, h2, d2 := hdlr.Box(`\$\textbackslash frac\{a\}\{\$`, fnt)
+	if w1 != w2 || h1 != h2 || d1 != d2 {
+		t.Errorf("invalid box: got=(%v, %v, %v), want=(%v, %v, %v)", w1, h1, d1, w2, h2, d2)
+	}
+
+	c := new(recorder.Canvas)
+	hdlr.Draw(c, `$\left( x^$`, sty, vg.Point{})
+	var txt strings.Builder
+	for _, a := range c.Actions {
+		if a, ok := a.(*recorder.FillString); ok {
+			txt.WriteString(a.String)
+		}
+	}
+	if got, want := txt.String(), `$\left( x^$`; got != want {
+		t.Errorf("invalid drawn text: got=%q, want=%q", got, want)
+	}
+}
+
+func TestLatexMath(t *testing.T) {
+	var (
+		hdlr = text.Latex{Fonts: font.NewCache(liberation.Collection())}
+		fnt  = font.Font{Variant: "Serif", Size: 12}
+	)
+	box := func(txt string) (w, h, d vg.Length) {
+		t.Helper()
+		if err := hdlr.Validate(txt); err != nil {
+			t.Fatalf("invalid text %q: %+v", txt, err)
+		}
+		return hdlr.Box(txt, fnt)
+	}
+
+	_, hx, dx := box(`$x$`)
+	for _, tc := range []struct {
+		txt          string
+		taller, deep bool
+	}{
+		{txt: `$\frac{a}{b}$`, taller: true, deep: true},
+		{txt: `$\dfrac{a}{b}$`, taller: true, deep: true},
+		{txt: `$\binom{n}{k}$`, taller: true, deep: true},
+		{txt: `$\sqrt{x}$`, taller: true},
+		{txt: `$\sqrt[3]{x}$`, taller: true},
+		{txt: `$\hat{x}$`, taller: true},
+		{txt: `$\overline{x}$`, taller: true},
+		{txt: `$\underline{x}$`, deep: true},
+		{txt: `$x^2$`, taller: true},
+		{txt: `$x_i$`, deep: true},
+		{txt: `$\displaystyle\sum_{i=0}^{n}$`, taller: true, deep: true},
+		{txt: `$\left(\frac{a}{b}\right)$`, taller: true, deep: true},
+		{txt: `$\begin{pmatrix} a \\ b \end{pmatrix}$`, taller: true, deep: true},
+	} {
+		w, h, d := box(tc.txt)
+		if w <= 0 {
+			t.Errorf("%s: invalid width: %v", tc.txt, w)
+		}
+		if tc.taller && h <= hx {
+			t.Errorf("%s: invalid height: got=%v, want > %v", tc.txt, h, hx)
+		}
+		if tc.deep && d <= dx {
+			t.Errorf("%s: invalid depth: got=%v, want > %v", tc.txt, d, dx)
+		}
+	}
+
+	// Limits are drawn above and below operators in display style,
+	// and as scripts otherwise.
+	wt, ht, _ := box(`$\sum_{i=0}^{n}$`)
+	wd, hd, _ := box(`$\sum\limits_{i=0}^{n}$`)
+	if wd >= wt || hd <= ht {
+		t.Errorf("invalid limits: got=(%v, %v), scripts=(%v, %v)", wd, hd, wt, ht)
+	}
+
+	// Fonts select the faces of the glyphs.
+	c := new(recorder.Canvas)
+	sty := text.Style{Font: fnt, Handler: hdlr}
+	hdlr.Draw(c, `$x\mathrm{x}\mathbf{x}\boldsymbol{x}\mathsf{x}$`, sty, vg.Point{})
+	var faces []string
+	for _, a := range c.Actions {
+		if a, ok := a.(*recorder.FillString); ok {
+			faces = append(faces, a.Font.Name())
+		}
+	}
+	want := []string{
+		"LiberationSerif-Italic",
+		"LiberationSerif-Regular",
+		"LiberationSerif-Bold",
+		"LiberationSerif-BoldItalic",
+		"LiberationSans-Regular",
+	}
+	if !reflect.DeepEqual(faces, want) {
+		t.Errorf("invalid faces:\ngot= %q\nwant=%q", faces, want)
+	}
+}
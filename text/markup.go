@@ -0,0 +1,302 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package text
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+
+	stdfnt "golang.org/x/image/font"
+
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/vg"
+)
+
+// Markup is a text handler for a lightweight markup language,
+// styling runs of a line of text:
+//
+//   - **bold** draws the text in bold,
+//   - *italic* draws the text in italics,
+//   - x^{2} or x^2 draws a superscript,
+//   - x_{i} or x_i draws a subscript,
+//   - <color=#f00>red</color> draws the text in the given
+//     color, written as #rgb, #rrggbb or #rrggbbaa.
+//
+// A backslash escapes the following character, as in \* or \_.
+// Styles do not span lines.
+type Markup struct {
+	// Fonts is the cache of font faces used by this text handler.
+	Fonts *font.Cache
+}
+
+var _ Handler = (*Markup)(nil)
+
+const (
+	// scriptScale is the font size of scripts
+	// relative to the font size of their base.
+	scriptScale = 0.7
+
+	// superRise and subDrop are the shifts of the
+	// baselines of superscripts and subscripts,
+	// relative to the font size of their base.
+	superRise = 0.35
+	subDrop   = 0.2
+)
+
+// Cache returns the cache of fonts used by the text handler.
+func (hdlr Markup) Cache() *font.Cache {
+	return hdlr.Fonts
+}
+
+// Extents returns the Extents of a font.
+func (hdlr Markup) Extents(fnt font.Font) font.Extents {
+	face := hdlr.Fonts.Lookup(fnt, fnt.Size)
+	return face.Extents()
+}
+
+// Lines splits a given block of text into separate lines.
+func (hdlr Markup) Lines(txt string) []string {
+	txt = strings.TrimRight(txt, "\n")
+	return strings.Split(txt, "\n")
+}
+
+// Box returns the bounding box of the given non-multiline text where:
+//   - width is the horizontal space from the origin.
+//   - height is the vertical space above the baseline.
+//   - depth is the vertical space below the baseline, a positive number.
+func (hdlr Markup) Box(txt string, fnt font.Font) (width, height, depth vg.Length) {
+	ext := hdlr.Extents(fnt)
+	width, height, depth = hdlr.layout(parseMarkup(txt), fnt)
+	return width, max(height, ext.Ascent), max(depth, ext.Descent)
+}
+
+// Draw renders the given text with the provided style and position
+// on the canvas.
+func (hdlr Markup) Draw(c vg.Canvas, txt string, sty Style, pt vg.Point) {
+	txt = strings.TrimRight(txt, "\n")
+	if len(txt) == 0 {
+		return
+	}
+
+	if sty.Rotation != 0 {
+		c.Push()
+		c.Rotate(sty.Rotation)
+	}
+
+	sin64, cos64 := math.Sincos(sty.Rotation)
+	cos := vg.Length(cos64)
+	sin := vg.Length(sin64)
+	pt.X, pt.Y = pt.Y*sin+pt.X*cos, pt.Y*cos-pt.X*sin
+
+	var (
+		ext   = hdlr.Extents(sty.Font)
+		lines = hdlr.Lines(txt)
+		runs  = make([][]markupRun, len(lines))
+		boxes = make([][3]vg.Length, len(lines))
+	)
+	for i, line := range lines {
+		runs[i] = parseMarkup(line)
+		w, h, d := hdlr.layout(runs[i], sty.Font)
+		boxes[i] = [3]vg.Length{w, max(h, ext.Ascent), max(d, ext.Descent)}
+	}
+
+	// Lay the lines out as the Plain handler does, with
+	// extra room for the scripts extending past the
+	// extents of the font.
+	ht := sty.Height(txt)
+	y := pt.Y + ht*vg.Length(sty.YAlign) - ext.Ascent + sty.Font.Size
+	for i := len(lines) - 1; i >= 0; i-- {
+		y += boxes[i][2] - ext.Descent
+		x := pt.X + vg.Length(sty.XAlign)*boxes[i][0]
+		for _, r := range runs[i] {
+			face := hdlr.face(r, sty.Font)
+			clr := r.color
+			if clr == nil {
+				clr = sty.Color
+			}
+			c.SetColor(clr)
+			x += fillRuns(c, hdlr.Fonts, face.Font, face.Font.Size, vg.Point{X: x, Y: y + vg.Length(r.rise)*sty.Font.Size}, r.txt)
+		}
+		y += boxes[i][1] - ext.Ascent + sty.Font.Size
+	}
+
+	if sty.Rotation != 0 {
+		c.Pop()
+	}
+}
+
+// layout returns the bounding box of the runs of a line
+// of text drawn with the base font fnt.
+func (hdlr Markup) layout(runs []markupRun, fnt font.Font) (width, height, depth vg.Length) {
+	for _, r := range runs {
+		face := hdlr.face(r, fnt)
+		ext := face.Extents()
+		rise := vg.Length(r.rise) * fnt.Size
+		width += runsWidth(hdlr.Fonts, face.Font, face.Font.Size, r.txt)
+		height = max(height, ext.Ascent+rise)
+		depth = max(depth, ext.Descent-rise)
+	}
+	return width, height, depth
+}
+
+// face returns the font face of the run drawn with the base font fnt.
+func (hdlr Markup) face(r markupRun, fnt font.Font) font.Face {
+	if r.bold {
+		fnt.Weight = stdfnt.WeightBold
+	}
+	if r.italic {
+		fnt.Style = stdfnt.StyleItalic
+	}
+	return hdlr.Fonts.Lookup(fnt, fnt.Size*vg.Length(r.scale))
+}
+
+// markupRun is a run of text drawn with the same style.
+type markupRun struct {
+	txt string
+
+	bold, italic bool
+
+	// scale is the font size of the run
+	// relative to the base font size.
+	scale float64
+
+	// rise is the shift of the baseline of the run
+	// relative to the base font size.
+	rise float64
+
+	// color is the color of the run. If nil,
+	// the color of the text style is used.
+	color color.Color
+}
+
+// parseMarkup returns the styled runs of a line of markup text.
+func parseMarkup(line string) []markupRun {
+	p := markupParser{src: []rune(line)}
+	p.parse(markupRun{scale: 1}, 0)
+	return p.runs
+}
+
+// markupParser parses a line of markup text into runs.
+type markupParser struct {
+	src    []rune
+	pos    int
+	runs   []markupRun
+	colors []color.Color
+}
+
+// parse parses the text with the style st until the end rune,
+// or until the end of the text if end is zero.
+func (p *markupParser) parse(st markupRun, end rune) {
+	var buf strings.Builder
+	flush := func() {
+		if buf.Len() == 0 {
+			return
+		}
+		r := st
+		r.txt = buf.String()
+		p.runs = append(p.runs, r)
+		buf.Reset()
+	}
+	defer flush()
+
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case end != 0 && c == end:
+			p.pos++
+			return
+		case c == '\\' && p.pos+1 < len(p.src):
+			buf.WriteRune(p.src[p.pos+1])
+			p.pos += 2
+		case c == '*':
+			flush()
+			if p.pos+1 < len(p.src) && p.src[p.pos+1] == '*' {
+				st.bold = !st.bold
+				p.pos += 2
+			} else {
+				st.italic = !st.italic
+				p.pos++
+			}
+		case (c == '^' || c == '_') && p.pos+1 < len(p.src):
+			flush()
+			script := st
+			script.scale *= scriptScale
+			if c == '^' {
+				script.rise += superRise * st.scale
+			} else {
+				script.rise -= subDrop * st.scale
+			}
+			p.pos++
+			if p.src[p.pos] == '{' {
+				p.pos++
+				p.parse(script, '}')
+				continue
+			}
+			script.txt = string(p.src[p.pos])
+			p.runs = append(p.runs, script)
+			p.pos++
+		case c == '<' && p.tag(&st, flush):
+		default:
+			buf.WriteRune(c)
+			p.pos++
+		}
+	}
+}
+
+// tag parses a color tag at the current position, updating the
+// style st after flushing the pending text. It returns false,
+// leaving the position unchanged, if there is no valid tag.
+func (p *markupParser) tag(st *markupRun, flush func()) bool {
+	rest := string(p.src[p.pos:])
+	i := strings.IndexByte(rest, '>')
+	if i < 0 {
+		return false
+	}
+	tag := rest[1:i]
+	switch {
+	case tag == "/color":
+		flush()
+		if n := len(p.colors); n > 0 {
+			st.color = p.colors[n-1]
+			p.colors = p.colors[:n-1]
+		}
+	case strings.HasPrefix(tag, "color="):
+		clr, ok := parseColor(strings.TrimPrefix(tag, "color="))
+		if !ok {
+			return false
+		}
+		flush()
+		p.colors = append(p.colors, st.color)
+		st.color = clr
+	default:
+		return false
+	}
+	p.pos += len([]rune(rest[:i+1]))
+	return true
+}
+
+// parseColor parses a color written as #rgb, #rrggbb or #rrggbbaa.
+func parseColor(s string) (color.Color, bool) {
+	if !strings.HasPrefix(s, "#") {
+		return nil, false
+	}
+	s = s[1:]
+	if len(s) == 3 {
+		s = string([]byte{s[0], s[0], s[1], s[1], s[2], s[2]})
+	}
+	if len(s) == 6 {
+		s += "ff"
+	}
+	if len(s) != 8 {
+		return nil, false
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return nil, false
+	}
+	return color.NRGBA{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: uint8(v)}, true
+}
@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package text_test
+
+import (
+	"image/color"
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/font/liberation"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/text"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+func ExampleMarkup() {
+	fonts := font.NewCache(liberation.Collection())
+	defer func(hdlr text.Handler) { plot.DefaultTextHandler = hdlr }(plot.DefaultTextHandler)
+	plot.DefaultTextHandler = text.Markup{
+		Fonts: fonts,
+	}
+
+	p := plot.New()
+	p.Title.Text = "CO_{2} concentration at **Mauna Loa**"
+	p.X.Label.Text = "*t* (years since 10^{3} days)"
+	p.Y.Label.Text = "CO_2 (ppm)"
+
+	p.X.Min = -1
+	p.X.Max = +1
+	p.Y.Min = -1
+	p.Y.Max = +1
+
+	labels, err := plotter.NewLabels(plotter.XYLabels{
+		XYs: []plotter.XY{
+			{X: -0.8, Y: +0.5},
+			{X: -0.8, Y: -0.5},
+			{X: +0.3, Y: +0.5},
+			{X: +0.3, Y: -0.5},
+		},
+		Labels: []string{
+			"*E* = *mc*^{2}",
+			"x_{i}^{2} + y_{j,k}",
+			"<color=#c00>red</color> and <color=#00c>**blue**</color>",
+			"two *styled*\n**lines**, 5\\*3",
+		},
+	})
+	if err != nil {
+		log.Fatalf("could not create labels: %+v", err)
+	}
+	for i := range labels.TextStyle {
+		labels.TextStyle[i].Font.Size = 16
+		labels.TextStyle[i].YAlign = draw.YCenter
+	}
+	labels.TextStyle[1].Color = color.RGBA{G: 128, A: 255}
+	labels.TextStyle[1].Rotation = math.Pi / 8
+	labels.TextStyle[3].XAlign = draw.XCenter
+
+	p.Add(labels)
+	p.Add(plotter.NewGlyphBoxes())
+	p.Add(plotter.NewGrid())
+
+	err = p.Save(12*vg.Centimeter, 8*vg.Centimeter, "testdata/markup.png")
+	if err != nil {
+		log.Fatalf("could not save plot: %+v", err)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package text_test
+
+import (
+	"testing"
+
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/font/liberation"
+	"gonum.org/v1/plot/text"
+)
+
+func TestMarkup(t *testing.T) {
+	cmpimg.CheckPlot(ExampleMarkup, t, "markup.png")
+}
+
+func TestMarkupBox(t *testing.T) {
+	fonts := font.NewCache(liberation.Collection())
+	plain := text.Plain{Fonts: fonts}
+	markup := text.Markup{Fonts: fonts}
+	fnt := font.Font{Variant: "Serif", Size: 12}
+
+	for _, txt := range []string{"", " ", "hello", "hello world", "1 < 2 > 0", "x^"} {
+		pw, ph, pd := plain.Box(txt, fnt)
+		mw, mh, md := markup.Box(txt, fnt)
+		if mw != pw || mh != ph || md != pd {
+			t.Errorf("unexpected box for %q: got:(%v, %v, %v) want:(%v, %v, %v)", txt, mw, mh, md, pw, ph, pd)
+		}
+	}
+
+	for _, test := range []struct {
+		markup, plain string
+	}{
+		{markup: `a\*b\_c`, plain: "a*b_c"},
+		{markup: "<color=#f00>red</color>", plain: "red"},
+		{markup: "<colour=#f00>red", plain: "<colour=#f00>red"},
+	} {
+		pw, _, _ := plain.Box(test.plain, fnt)
+		mw, _, _ := markup.Box(test.markup, fnt)
+		if mw != pw {
+			t.Errorf("unexpected width for %q: got:%v want:%v", test.markup, mw, pw)
+		}
+	}
+
+	pw, _, _ := plain.Box("hello", fnt)
+	if w, _, _ := markup.Box("**hello**", fnt); w <= pw {
+		t.Errorf("bold text is not wider: got:%v plain:%v", w, pw)
+	}
+
+	base, h, d := markup.Box("x", fnt)
+	if w, sh, _ := markup.Box("x^{2}", fnt); sh <= h || w <= base {
+		t.Errorf("superscript does not extend the box: got:(%v, %v) base:(%v, %v)", w, sh, base, h)
+	}
+	if _, _, sd := markup.Box("x_{i}", fnt); sd <= d {
+		t.Errorf("subscript does not extend the depth: got:%v base:%v", sd, d)
+	}
+	w1, _, _ := markup.Box("x^2", fnt)
+	w2, _, _ := markup.Box("x^{2}", fnt)
+	if w1 != w2 {
+		t.Errorf("unexpected width of single rune superscript: got:%v want:%v", w1, w2)
+	}
+}
@@ -7,12 +7,16 @@ package text // import "gonum.org/v1/plot/text"
 import (
 	"math"
 	"strings"
+	"unicode/utf8"
 
 	"gonum.org/v1/plot/font"
 	"gonum.org/v1/plot/vg"
 )
 
 // Plain is a text/plain handler.
+//
+// Runes missing from the font of the text are drawn with
+// the fallback fonts of the cache, if any.
 type Plain struct {
 	Fonts *font.Cache
 }
@@ -43,7 +47,7 @@ func (hdlr Plain) Lines(txt string) []string {
 func (hdlr Plain) Box(txt string, fnt font.Font) (width, height, depth vg.Length) {
 	face := hdlr.Fonts.Lookup(fnt, fnt.Size)
 	ext := face.Extents()
-	width = face.Width(txt)
+	width = runsWidth(hdlr.Fonts, fnt, fnt.Size, txt)
 	height = ext.Ascent
 	depth = ext.Descent
 
@@ -75,12 +79,63 @@ func (hdlr Plain) Draw(c vg.Canvas, txt string, sty Style, pt vg.Point) {
 	ht := sty.Height(txt)
 	pt.Y += ht*vg.Length(sty.YAlign) - fnt.Extents().Ascent
 	for i, line := range lines {
-		xoffs := vg.Length(sty.XAlign) * fnt.Width(line)
+		xoffs := vg.Length(sty.XAlign) * runsWidth(hdlr.Fonts, sty.Font, sty.Font.Size, line)
 		n := vg.Length(len(lines) - i)
-		c.FillString(fnt, pt.Add(vg.Point{X: xoffs, Y: n * sty.Font.Size}), line)
+		fillRuns(c, hdlr.Fonts, sty.Font, sty.Font.Size, pt.Add(vg.Point{X: xoffs, Y: n * sty.Font.Size}), line)
 	}
 
 	if sty.Rotation != 0 {
 		c.Pop()
 	}
 }
+
+// runsWidth returns the width of the text drawn with the font
+// fnt of the given size, drawing the runes missing from fnt
+// with the fallback font faces of the cache.
+func runsWidth(fonts *font.Cache, fnt font.Font, size vg.Length, txt string) vg.Length {
+	_, _, w := layoutRuns(fonts, fnt, size, txt)
+	return w
+}
+
+// fillRuns fills the text at pt with the font fnt of the given
+// size, drawing the runes missing from fnt with the fallback
+// font faces of the cache. It returns the width of the text.
+func fillRuns(c vg.Canvas, fonts *font.Cache, fnt font.Font, size vg.Length, pt vg.Point, txt string) vg.Length {
+	runs, xs, w := layoutRuns(fonts, fnt, size, txt)
+	for i, run := range runs {
+		c.FillString(run.Face, pt.Add(vg.Point{X: xs[i]}), run.Text)
+	}
+	return w
+}
+
+// layoutRuns returns the runs of the text drawn with the font fnt
+// of the given size, as returned by the Runs method of the cache,
+// the offsets of the runs from the start of the text and the width
+// of the text.
+//
+// The glyphs on each side of the boundary between two runs are
+// kerned by the face of the second run, or else of the first one,
+// when that face has glyphs and a kerning for both of them.
+func layoutRuns(fonts *font.Cache, fnt font.Font, size vg.Length, txt string) (runs []font.Run, xs []vg.Length, width vg.Length) {
+	runs = fonts.Runs(fnt, size, txt)
+	xs = make([]vg.Length, len(runs))
+	for i, run := range runs {
+		if i > 0 {
+			width += kern(runs[i-1], run)
+		}
+		xs[i] = width
+		width += run.Face.Width(run.Text)
+	}
+	return runs, xs, width
+}
+
+// kern returns the kerning between the last glyph of the run
+// prev and the first glyph of the run next.
+func kern(prev, next font.Run) vg.Length {
+	r0, _ := utf8.DecodeLastRuneInString(prev.Text)
+	r1, _ := utf8.DecodeRuneInString(next.Text)
+	if k := next.Face.Kern(r0, r1); k != 0 {
+		return k
+	}
+	return prev.Face.Kern(r0, r1)
+}
@@ -0,0 +1,56 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package text_test
+
+import (
+	"log"
+
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/font/liberation"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/text"
+	"gonum.org/v1/plot/vg"
+)
+
+func ExamplePlain_fallback() {
+	// The Liberation fonts have no glyphs for most superscript
+	// digits. Draw them with the Go fonts instead.
+	goreg, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		log.Fatalf("could not parse Go font: %+v", err)
+	}
+
+	fonts := font.NewCache(liberation.Collection())
+	fonts.AddFallback(font.Collection{
+		{Font: font.Font{Typeface: "Go"}, Face: goreg},
+	})
+
+	defer func(hdlr text.Handler) { plot.DefaultTextHandler = hdlr }(plot.DefaultTextHandler)
+	plot.DefaultTextHandler = text.Plain{
+		Fonts: fonts,
+	}
+
+	p := plot.New()
+	p.Title.Text = "Decay of a sample over 10⁹ years"
+	p.X.Label.Text = "t (10⁹ years)"
+	p.Y.Label.Text = "N (×10⁻³ mol)"
+
+	p.X.Min = 0
+	p.X.Max = 5
+	p.Y.Min = 0
+	p.Y.Max = 1
+
+	decay := plotter.NewFunction(func(x float64) float64 { return 1 / (1 + x*x) })
+	p.Add(decay)
+
+	err = p.Save(10*vg.Centimeter, 8*vg.Centimeter, "testdata/fallback.png")
+	if err != nil {
+		log.Fatalf("could not save plot: %+v", err)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package text
+
+import (
+	"testing"
+
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/font/liberation"
+	"gonum.org/v1/plot/vg"
+)
+
+func TestKernRuns(t *testing.T) {
+	goreg, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		t.Fatalf("could not parse Go font: %+v", err)
+	}
+	// The Go font has no kerning.
+	gofnt := font.Face{Font: font.Font{Typeface: "Go", Size: 12}, Face: goreg}
+	lib := font.NewCache(liberation.Collection()).Lookup(font.Font{Typeface: "Liberation", Variant: "Serif"}, 12)
+	av := lib.Kern('A', 'V')
+	if av == 0 {
+		t.Fatal("missing kerning of AV")
+	}
+
+	for _, tc := range []struct {
+		name       string
+		prev, next font.Run
+		want       vg.Length
+	}{
+		{
+			name: "next face",
+			prev: font.Run{Face: gofnt, Text: "xA"},
+			next: font.Run{Face: lib, Text: "Vx"},
+			want: av,
+		},
+		{
+			name: "previous face",
+			prev: font.Run{Face: lib, Text: "xA"},
+			next: font.Run{Face: gofnt, Text: "Vx"},
+			want: av,
+		},
+		{
+			name: "no kerning",
+			prev: font.Run{Face: lib, Text: "A"},
+			next: font.Run{Face: gofnt, Text: "⁰"},
+			want: 0,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := kern(tc.prev, tc.next); got != tc.want {
+				t.Errorf("invalid kerning: got=%v, want=%v", got, tc.want)
+			}
+		})
+	}
+}
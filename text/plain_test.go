@@ -9,6 +9,7 @@ import (
 
 	stdfnt "golang.org/x/image/font"
 
+	"gonum.org/v1/plot/cmpimg"
 	"gonum.org/v1/plot/font"
 	"gonum.org/v1/plot/font/liberation"
 	"gonum.org/v1/plot/text"
@@ -173,3 +174,7 @@ func TestPlainText(t *testing.T) {
 		})
 	}
 }
+
+func TestPlainFallback(t *testing.T) {
+	cmpimg.CheckPlot(ExamplePlain_fallback, t, "fallback.png")
+}
@@ -0,0 +1,275 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package text
+
+import (
+	"strings"
+	"unicode"
+
+	"gonum.org/v1/plot/vg"
+)
+
+// Wrap specifies how the lines of a text are laid out within
+// a maximum width.
+//
+// Lines are wrapped at spaces and, for Chinese, Japanese and Korean
+// text, between characters. Words wider than the maximum width are
+// broken between characters. Wrapping is done before the text is
+// handed to its Handler, so each wrapped line must be valid on its
+// own: styles of the Markup handler do not span lines, and LaTeX
+// text should not be wrapped.
+type Wrap struct {
+	// Width is the maximum width of the lines of text.
+	// If Width is zero, the lines are neither wrapped
+	// nor truncated.
+	Width vg.Length
+
+	// Truncate specifies whether the lines wider than
+	// Width are truncated, ending with Ellipsis, instead
+	// of being wrapped.
+	Truncate bool
+
+	// MaxLines is the maximum number of lines of the text.
+	// The last line of a text with more lines is truncated,
+	// ending with Ellipsis. If MaxLines is zero, the number
+	// of lines is not limited.
+	MaxLines int
+
+	// Ellipsis ends truncated lines. If Ellipsis is
+	// empty, a horizontal ellipsis "…" is used.
+	Ellipsis string
+
+	// Justify specifies whether the spaces of the wrapped
+	// lines are widened such that the lines fill Width, to
+	// within the width of a space. The last line of each
+	// paragraph is not justified. The lines are aligned
+	// as specified by the XAlign of their Style.
+	Justify bool
+}
+
+// Text returns the text laid out within the maximum width
+// when drawn with the style sty. The lines of the returned
+// text are separated by newlines.
+func (w Wrap) Text(sty Style, txt string) string {
+	if w.Width <= 0 && w.MaxLines <= 0 || txt == "" {
+		return txt
+	}
+
+	var lines []string
+	for _, para := range sty.Handler.Lines(txt) {
+		switch {
+		case w.Width <= 0:
+			lines = append(lines, para)
+		case w.Truncate:
+			lines = append(lines, w.truncate(sty, para, false))
+		default:
+			lines = append(lines, w.wrap(sty, para)...)
+		}
+	}
+	if w.MaxLines > 0 && len(lines) > w.MaxLines {
+		lines = lines[:w.MaxLines]
+		lines[len(lines)-1] = w.truncate(sty, lines[len(lines)-1], true)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// wrapWord is a word of text, the unit of line wrapping.
+type wrapWord struct {
+	txt   string
+	space string // space is the run of spaces preceding the word.
+}
+
+// wrap returns the lines of the paragraph para wrapped within
+// the maximum width.
+func (w Wrap) wrap(sty Style, para string) []string {
+	var (
+		lines   []string
+		line    string
+		words   int // words is the number of words of the line.
+		justify = func(line string, words int) string {
+			if w.Justify && words > 1 {
+				return w.justify(sty, line)
+			}
+			return line
+		}
+	)
+	for i, word := range wrapWords(para) {
+		if i == 0 {
+			// Keep the indentation of the paragraph.
+			line, words = w.breakWord(sty, word.space+word.txt, &lines), 1
+			continue
+		}
+		next := line + word.space + word.txt
+		if sty.Width(next) <= w.Width {
+			line = next
+			words++
+			continue
+		}
+		lines = append(lines, justify(line, words))
+		line, words = w.breakWord(sty, word.txt, &lines), 1
+	}
+	return append(lines, line)
+}
+
+// breakWord breaks the word wider than the maximum width between
+// characters, appending all but its last part to lines. It returns
+// the last part of the word.
+func (w Wrap) breakWord(sty Style, word string, lines *[]string) string {
+	if sty.Width(word) <= w.Width {
+		return word
+	}
+	var part []rune
+	for _, r := range word {
+		if len(part) > 0 && sty.Width(string(append(part, r))) > w.Width {
+			*lines = append(*lines, string(part))
+			part = part[:0]
+		}
+		part = append(part, r)
+	}
+	return string(part)
+}
+
+// justify returns the line with its spaces widened such that
+// it fills the maximum width.
+func (w Wrap) justify(sty Style, line string) string {
+	// The gaps are the runs of spaces between words,
+	// leaving the indentation of the line unchanged.
+	isGap := func(prev, r rune) bool {
+		return r == ' ' && prev != ' ' && prev != 0
+	}
+	var (
+		gaps int
+		prev rune
+	)
+	for _, r := range line {
+		if isGap(prev, r) {
+			gaps++
+		}
+		prev = r
+	}
+	space := sty.Width(" ")
+	if gaps == 0 || space <= 0 {
+		return line
+	}
+	extra := int((w.Width - sty.Width(line)) / space)
+	if extra <= 0 {
+		return line
+	}
+	var (
+		o   strings.Builder
+		gap int
+	)
+	prev = 0
+	for _, r := range line {
+		o.WriteRune(r)
+		if !isGap(prev, r) {
+			prev = r
+			continue
+		}
+		prev = r
+		// Distribute the extra spaces evenly, the
+		// leftmost gaps taking the remaining ones.
+		n := extra / gaps
+		if gap < extra%gaps {
+			n++
+		}
+		o.WriteString(strings.Repeat(" ", n))
+		gap++
+	}
+	return o.String()
+}
+
+// truncate returns the line truncated to the maximum width,
+// ending with the ellipsis. If force is true, the line ends
+// with the ellipsis even when it is not truncated.
+func (w Wrap) truncate(sty Style, line string, force bool) string {
+	if !force && (w.Width <= 0 || sty.Width(line) <= w.Width) {
+		return line
+	}
+	ellipsis := w.Ellipsis
+	if ellipsis == "" {
+		ellipsis = "…"
+	}
+	txt := []rune(strings.TrimRightFunc(line, unicode.IsSpace))
+	for len(txt) > 0 {
+		cut := strings.TrimRightFunc(string(txt), unicode.IsSpace) + ellipsis
+		if w.Width <= 0 || sty.Width(cut) <= w.Width {
+			return cut
+		}
+		txt = txt[:len(txt)-1]
+	}
+	return ellipsis
+}
+
+// wrapWords returns the words of the paragraph para. Words are
+// separated by runs of spaces, which are kept with the following
+// word. Each Chinese, Japanese and Korean character is a word on
+// its own, with the punctuation following it. Trailing spaces are
+// dropped.
+func wrapWords(para string) []wrapWord {
+	var (
+		words []wrapWord
+		cur   strings.Builder
+		space strings.Builder
+	)
+	flush := func() {
+		if cur.Len() == 0 {
+			return
+		}
+		words = append(words, wrapWord{txt: cur.String(), space: space.String()})
+		cur.Reset()
+		space.Reset()
+	}
+	for _, r := range para {
+		switch {
+		case r == ' ':
+			flush()
+			space.WriteRune(r)
+		case isCJKPunct(r):
+			// Closing punctuation does not start a line.
+			cur.WriteRune(r)
+		case isCJK(r):
+			flush()
+			cur.WriteRune(r)
+		default:
+			if isCJKWord(cur.String()) {
+				flush()
+			}
+			cur.WriteRune(r)
+		}
+	}
+	flush()
+	return words
+}
+
+// isCJKWord returns whether the word is a Chinese, Japanese
+// or Korean character, possibly followed by punctuation.
+func isCJKWord(word string) bool {
+	for _, r := range word {
+		return isCJK(r)
+	}
+	return false
+}
+
+// isCJK returns whether the rune is a Chinese, Japanese or Korean
+// character, which lines may be broken before and after.
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) ||
+		unicode.Is(cjkSymbols, r)
+}
+
+// isCJKPunct returns whether the rune is a Chinese, Japanese or
+// Korean punctuation mark which lines may not start with.
+func isCJKPunct(r rune) bool {
+	return strings.ContainsRune("、。，．：；！？）」』】〉》〕", r)
+}
+
+// cjkSymbols are the CJK symbols and fullwidth forms.
+var cjkSymbols = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x3000, Hi: 0x303f, Stride: 1},
+		{Lo: 0xff00, Hi: 0xffef, Stride: 1},
+	},
+}
@@ -0,0 +1,133 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package text_test
+
+import (
+	"strings"
+	"testing"
+
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/font/liberation"
+	"gonum.org/v1/plot/text"
+	"gonum.org/v1/plot/vg"
+)
+
+func TestWrap(t *testing.T) {
+	sty := text.Style{
+		Font:    font.Font{Typeface: "Liberation", Variant: "Mono", Size: 10},
+		Handler: text.Plain{Fonts: font.NewCache(liberation.Collection())},
+	}
+	// cols returns the width of n columns of the monospace
+	// font, with some leeway for rounding.
+	cols := func(n int) vg.Length {
+		return (vg.Length(n) + 0.5) * sty.Width("x")
+	}
+
+	for _, tc := range []struct {
+		name string
+		wrap text.Wrap
+		txt  string
+		want string
+	}{
+		{
+			name: "no-width",
+			wrap: text.Wrap{},
+			txt:  "the quick brown fox",
+			want: "the quick brown fox",
+		},
+		{
+			name: "fits",
+			wrap: text.Wrap{Width: cols(19)},
+			txt:  "the quick brown fox",
+			want: "the quick brown fox",
+		},
+		{
+			name: "words",
+			wrap: text.Wrap{Width: cols(10)},
+			txt:  "the quick brown fox jumps over the lazy dog",
+			want: "the quick\nbrown fox\njumps over\nthe lazy\ndog",
+		},
+		{
+			name: "paragraphs",
+			wrap: text.Wrap{Width: cols(10)},
+			txt:  "the quick brown fox\njumps over the lazy dog\n",
+			want: "the quick\nbrown fox\njumps over\nthe lazy\ndog",
+		},
+		{
+			// Runs of spaces and the indentation of the
+			// paragraph are kept, spaces at line breaks and
+			// trailing spaces are dropped.
+			name: "spaces",
+			wrap: text.Wrap{Width: cols(13)},
+			txt:  "  the   quick  brown fox ",
+			want: "  the   quick\nbrown fox",
+		},
+		{
+			name: "justify-spaces",
+			wrap: text.Wrap{Width: cols(12), Justify: true},
+			txt:  "  a  bb cc ddddd",
+			want: "  a   bb  cc\nddddd",
+		},
+		{
+			name: "long-word",
+			wrap: text.Wrap{Width: cols(4)},
+			txt:  "a supercalifragilistic word",
+			want: "a\nsupe\nrcal\nifra\ngili\nstic\nword",
+		},
+		{
+			// Closing punctuation stays with the preceding
+			// character.
+			name: "cjk",
+			wrap: text.Wrap{Width: cols(4)},
+			txt:  "温度测量。Temp",
+			want: "温度测\n量。\nTemp",
+		},
+		{
+			name: "truncate",
+			wrap: text.Wrap{Width: cols(10), Truncate: true},
+			txt:  "the quick brown fox\nshort",
+			want: "the quick…\nshort",
+		},
+		{
+			name: "truncate-spaces",
+			wrap: text.Wrap{Width: cols(6), Truncate: true, Ellipsis: "..."},
+			txt:  "the quick",
+			want: "the...",
+		},
+		{
+			name: "max-lines",
+			wrap: text.Wrap{Width: cols(10), MaxLines: 2},
+			txt:  "the quick brown fox jumps over the lazy dog",
+			want: "the quick\nbrown fox…",
+		},
+		{
+			name: "max-lines-no-width",
+			wrap: text.Wrap{MaxLines: 1},
+			txt:  "one\ntwo",
+			want: "one…",
+		},
+		{
+			name: "justify",
+			wrap: text.Wrap{Width: cols(12), Justify: true},
+			txt:  "a bb cc ddddd eeeeeeee f",
+			want: "a    bb   cc\nddddd\neeeeeeee f",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.wrap.Text(sty, tc.txt)
+			if got != tc.want {
+				t.Errorf("invalid wrapped text:\ngot= %q\nwant=%q", got, tc.want)
+			}
+			if tc.wrap.Width <= 0 {
+				return
+			}
+			for _, line := range strings.Split(got, "\n") {
+				if w := sty.Width(line); w > tc.wrap.Width {
+					t.Errorf("line %q too wide: got=%v, max=%v", line, w, tc.wrap.Width)
+				}
+			}
+		})
+	}
+}
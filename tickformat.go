@@ -0,0 +1,355 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"gonum.org/v1/plot/text"
+)
+
+// TickFormatter formats the labels of the major tick marks of an axis.
+type TickFormatter interface {
+	// Format returns the labels of the major tick marks at
+	// the given values, and an annotation shared by all the
+	// labels, such as a common multiplier or offset, that is
+	// drawn near the end of the axis. The labels must not be
+	// empty. The annotation may be empty.
+	Format(values []float64) (labels []string, annotation string)
+}
+
+// TickAnnotator is a Ticker annotating its tick labels with a text
+// shared by all the labels. The annotation is drawn below the right
+// end of the tick labels of a horizontal axis, and above the top end
+// of a vertical axis.
+type TickAnnotator interface {
+	Ticker
+
+	// Annotation returns the annotation of the tick labels
+	// of an axis with the given range.
+	Annotation(min, max float64) string
+}
+
+// FormatTicks is suitable for the Tick.Marker field of an Axis.
+// It labels the major tick marks returned by Ticker with Format.
+type FormatTicks struct {
+	// Ticker is used to generate a set of ticks.
+	// If nil, DefaultTicks will be used.
+	Ticker Ticker
+
+	// Format formats the labels of the major tick marks.
+	Format TickFormatter
+}
+
+var _ TickAnnotator = FormatTicks{}
+
+// Ticks implements plot.Ticker.
+func (t FormatTicks) Ticks(min, max float64) []Tick {
+	ticks, _ := t.format(min, max)
+	return ticks
+}
+
+// Annotation implements plot.TickAnnotator.
+func (t FormatTicks) Annotation(min, max float64) string {
+	_, annotation := t.format(min, max)
+	return annotation
+}
+
+// format returns the tick marks of the axis with
+// the given range and the annotation of their labels.
+func (t FormatTicks) format(min, max float64) ([]Tick, string) {
+	if t.Ticker == nil {
+		t.Ticker = DefaultTicks{}
+	}
+	ticks := append([]Tick(nil), t.Ticker.Ticks(min, max)...)
+	var (
+		major  []int
+		values []float64
+	)
+	for i, tick := range ticks {
+		if tick.IsMinor() {
+			continue
+		}
+		major = append(major, i)
+		values = append(values, tick.Value)
+	}
+	if len(values) == 0 {
+		return ticks, ""
+	}
+	labels, annotation := t.Format.Format(values)
+	for j, i := range major {
+		ticks[i].Label = labels[j]
+	}
+	return ticks, annotation
+}
+
+// annotatedTicks is a Ticker returning fixed tick marks and annotation.
+type annotatedTicks struct {
+	ConstantTicks
+	annotation string
+}
+
+// Annotation implements plot.TickAnnotator.
+func (t annotatedTicks) Annotation(float64, float64) string {
+	return t.annotation
+}
+
+// constantTicks returns a Ticker returning the tick marks,
+// with the annotation of the Ticker of the axis, if any.
+func (a Axis) constantTicks(marks []Tick) Ticker {
+	if _, ok := a.Tick.Marker.(TickAnnotator); ok {
+		return annotatedTicks{ConstantTicks: marks, annotation: a.annotation()}
+	}
+	return ConstantTicks(marks)
+}
+
+// ScientificFormat formats tick labels in scientific notation,
+// with a power of ten shared by all the labels and given by the
+// annotation, such as "×10⁶".
+type ScientificFormat struct {
+	// Prec is the maximum number of significant digits
+	// of the labels. Zero uses as many digits as needed.
+	Prec int
+
+	// Handler is the text handler drawing the annotation.
+	// The exponent of the annotation is written as a
+	// superscript of the math mode of a text.Latex handler,
+	// as in $\times 10^{6}$, as a superscript of the markup
+	// of a text.Markup handler, as in ×10^{6}, and otherwise
+	// with Unicode superscript digits, as in ×10⁶, which must
+	// then be provided by the font or its fallback fonts.
+	Handler text.Handler
+}
+
+// Format implements plot.TickFormatter.
+func (f ScientificFormat) Format(values []float64) ([]string, string) {
+	var max float64
+	for _, v := range values {
+		max = math.Max(max, math.Abs(v))
+	}
+	exp := 0
+	if max > 0 {
+		exp = int(math.Floor(math.Log10(max)))
+	}
+	labels := make([]string, len(values))
+	for i, v := range values {
+		labels[i] = formatSig(v/math.Pow10(exp), f.Prec)
+	}
+	if exp == 0 {
+		return labels, ""
+	}
+	e := strconv.Itoa(exp)
+	switch f.Handler.(type) {
+	case text.Latex, *text.Latex:
+		return labels, `$\times 10^{` + e + `}$`
+	case text.Markup, *text.Markup:
+		return labels, "×10^{" + e + "}"
+	default:
+		return labels, "×10" + strings.Map(superscript, e)
+	}
+}
+
+// superscript returns the Unicode superscript
+// of the digit or minus sign r.
+func superscript(r rune) rune {
+	if r == '-' {
+		return '⁻'
+	}
+	return []rune("⁰¹²³⁴⁵⁶⁷⁸⁹")[r-'0']
+}
+
+// EngineeringFormat formats tick labels in engineering notation,
+// with exponents multiple of three, such as "150e3" or "1.5e6".
+type EngineeringFormat struct {
+	// Prec is the maximum number of significant digits
+	// of the labels. Zero uses as many digits as needed.
+	Prec int
+}
+
+// Format implements plot.TickFormatter.
+func (f EngineeringFormat) Format(values []float64) ([]string, string) {
+	labels := make([]string, len(values))
+	for i, v := range values {
+		exp := exponent3(v, math.MinInt, math.MaxInt)
+		labels[i] = formatSig(v/math.Pow10(exp), f.Prec)
+		if exp != 0 {
+			labels[i] += "e" + strconv.Itoa(exp)
+		}
+	}
+	return labels, ""
+}
+
+// SIFormat formats tick labels with SI prefixes
+// and a unit, such as "1.5 kHz" or "200 µs".
+type SIFormat struct {
+	// Unit is the unit appended to the labels,
+	// after the SI prefix.
+	Unit string
+
+	// Prec is the maximum number of significant digits
+	// of the labels. Zero uses as many digits as needed.
+	Prec int
+}
+
+// siPrefixes are the SI prefixes from 10⁻²⁴ to 10²⁴.
+var siPrefixes = []string{
+	"y", "z", "a", "f", "p", "n", "µ", "m", "",
+	"k", "M", "G", "T", "P", "E", "Z", "Y",
+}
+
+// Format implements plot.TickFormatter.
+func (f SIFormat) Format(values []float64) ([]string, string) {
+	labels := make([]string, len(values))
+	for i, v := range values {
+		exp := exponent3(v, -24, 24)
+		suffix := siPrefixes[exp/3+8] + f.Unit
+		labels[i] = formatSig(v/math.Pow10(exp), f.Prec)
+		switch {
+		case suffix == "":
+		case f.Unit == "":
+			labels[i] += suffix
+		default:
+			labels[i] += " " + suffix
+		}
+	}
+	return labels, ""
+}
+
+// PercentFormat formats tick labels of fractions
+// as percentages, such as "25%" for 0.25.
+type PercentFormat struct {
+	// Prec is the maximum number of significant digits
+	// of the labels. Zero uses as many digits as needed.
+	Prec int
+}
+
+// Format implements plot.TickFormatter.
+func (f PercentFormat) Format(values []float64) ([]string, string) {
+	labels := make([]string, len(values))
+	for i, v := range values {
+		labels[i] = formatSig(100*v, f.Prec) + "%"
+	}
+	return labels, ""
+}
+
+// CurrencyFormat formats tick labels as amounts of money,
+// with thousands separators, such as "$1,250.00".
+type CurrencyFormat struct {
+	// Symbol is the currency symbol, such as "$" or "€".
+	Symbol string
+
+	// Decimals is the number of decimal places of the labels.
+	Decimals int
+
+	// Suffix specifies whether the symbol follows the
+	// amount, separated by a space, instead of preceding it.
+	Suffix bool
+}
+
+// Format implements plot.TickFormatter.
+func (f CurrencyFormat) Format(values []float64) ([]string, string) {
+	labels := make([]string, len(values))
+	for i, v := range values {
+		amount := strconv.FormatFloat(math.Abs(v), 'f', f.Decimals, 64)
+		integer, fraction, _ := strings.Cut(amount, ".")
+		var sb strings.Builder
+		if math.Round(v*math.Pow10(f.Decimals)) < 0 {
+			sb.WriteString("-")
+		}
+		if !f.Suffix {
+			sb.WriteString(f.Symbol)
+		}
+		for j, r := range integer {
+			if j > 0 && (len(integer)-j)%3 == 0 {
+				sb.WriteString(",")
+			}
+			sb.WriteRune(r)
+		}
+		if fraction != "" {
+			sb.WriteString("." + fraction)
+		}
+		if f.Suffix && f.Symbol != "" {
+			sb.WriteString(" " + f.Symbol)
+		}
+		labels[i] = sb.String()
+	}
+	return labels, ""
+}
+
+// OffsetFormat formats tick labels of values spanning a range narrow
+// relative to their magnitude as their difference to a common offset,
+// given by the annotation, such as "+1.7e+09". The offset is only used
+// when it spares at least four leading digits of the labels.
+type OffsetFormat struct {
+	// Prec is the maximum number of significant digits
+	// of the labels. Zero uses as many digits as needed.
+	Prec int
+}
+
+// Format implements plot.TickFormatter.
+func (f OffsetFormat) Format(values []float64) ([]string, string) {
+	lo, hi := math.Inf(+1), math.Inf(-1)
+	for _, v := range values {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+	var offset float64
+	prec := f.Prec
+	if r := hi - lo; r > 0 {
+		unit := math.Pow10(int(math.Ceil(math.Log10(r))))
+		offset = math.Floor(lo/unit) * unit
+		spared := 0
+		if offset != 0 {
+			spared = int(math.Log10(math.Abs(offset) / unit))
+		}
+		switch {
+		case spared < 4:
+			offset = 0
+		case prec <= 0:
+			// The digits spared by the offset are lost
+			// to the rounding error of the differences.
+			prec = max(12-spared, 1)
+		}
+	}
+	labels := make([]string, len(values))
+	for i, v := range values {
+		labels[i] = formatSig(v-offset, prec)
+	}
+	if offset == 0 {
+		return labels, ""
+	}
+	annotation := strconv.FormatFloat(offset, 'g', -1, 64)
+	if offset > 0 {
+		annotation = "+" + annotation
+	}
+	return labels, annotation
+}
+
+// formatSig returns a g-formated string representation of v with
+// at most prec significant digits, or as many digits as needed up
+// to twelve if prec is not positive.
+func formatSig(v float64, prec int) string {
+	if prec <= 0 {
+		prec = 12
+	}
+	s := strconv.FormatFloat(v, 'g', prec, 64)
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
+
+// exponent3 returns the largest multiple of three not greater than
+// the decimal exponent of v, clamped to [lo, hi].
+func exponent3(v float64, lo, hi int) int {
+	if v == 0 {
+		return 0
+	}
+	exp := int(math.Floor(math.Log10(math.Abs(v))))
+	exp = int(math.Floor(float64(exp)/3)) * 3
+	return min(max(exp, lo), hi)
+}
@@ -0,0 +1,75 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"log"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/text"
+)
+
+// ExampleFormatTicks draws plots with tick labels formatted in
+// scientific and engineering notations, with SI prefixes, as
+// percentages and amounts of money, and relative to an offset.
+func ExampleFormatTicks() {
+	newPlot := func(title string, xmin, xmax, ymin, ymax float64) *plot.Plot {
+		p := plot.New()
+		p.Title.Text = title
+		p.X.Min, p.X.Max = xmin, xmax
+		p.Y.Min, p.Y.Max = ymin, ymax
+		p.Add(plotter.NewGrid())
+		return p
+	}
+
+	// The Markup handler draws the exponents of the
+	// scientific notation as superscripts.
+	markup := text.Markup{Fonts: font.DefaultCache}
+	sci := newPlot("Scientific", 0, 3e6, 0, 1.2e-4)
+	sci.X.Tick.Label.Handler = markup
+	sci.X.Tick.Marker = plot.FormatTicks{Format: plot.ScientificFormat{Handler: markup}}
+	sci.Y.Tick.Label.Handler = markup
+	sci.Y.Tick.Marker = plot.FormatTicks{Format: plot.ScientificFormat{Handler: markup}}
+
+	eng := newPlot("Engineering", 0, 3e6, 0, 1.2e-4)
+	eng.X.Tick.Marker = plot.FormatTicks{Format: plot.EngineeringFormat{}}
+	eng.Y.Tick.Marker = plot.FormatTicks{Format: plot.EngineeringFormat{}}
+
+	si := newPlot("SI prefixes", 10, 1e5, 0, 2.5e-3)
+	si.X.Scale = plot.LogScale{}
+	si.X.Tick.Marker = plot.FormatTicks{
+		Ticker: plot.LogTicks{},
+		Format: plot.SIFormat{Unit: "Hz"},
+	}
+	si.X.Tick.Overlap = plot.OverlapAuto
+	si.Y.Tick.Marker = plot.FormatTicks{Format: plot.SIFormat{Unit: "s"}}
+
+	pct := newPlot("Percentage", 0, 1, -0.05, 0.1)
+	pct.X.Tick.Marker = plot.FormatTicks{Format: plot.PercentFormat{}}
+	pct.Y.Tick.Marker = plot.FormatTicks{Format: plot.PercentFormat{}}
+
+	money := newPlot("Currency", 0, 25000, -500, 1500)
+	money.X.Tick.Marker = plot.FormatTicks{Format: plot.CurrencyFormat{Symbol: "$"}}
+	money.Y.Tick.Marker = plot.FormatTicks{Format: plot.CurrencyFormat{Symbol: "€", Decimals: 2, Suffix: true}}
+
+	offset := newPlot("Offset", 1.7e9+100, 1.7e9+400, 42.0001, 42.0005)
+	offset.X.Tick.Marker = plot.FormatTicks{Format: plot.OffsetFormat{}}
+	offset.Y.Tick.Marker = plot.FormatTicks{Format: plot.OffsetFormat{}}
+
+	fig := plot.NewFigure(2, 3)
+	fig.Add(sci, 0, 0)
+	fig.Add(eng, 0, 1)
+	fig.Add(si, 0, 2)
+	fig.Add(pct, 1, 0)
+	fig.Add(money, 1, 1)
+	fig.Add(offset, 1, 2)
+
+	err := fig.Save(600, 400, "testdata/tick_format.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
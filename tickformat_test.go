@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/text"
+)
+
+func TestFormatTicks(t *testing.T) {
+	cmpimg.CheckPlot(ExampleFormatTicks, t, "tick_format.png")
+}
+
+func TestTickFormatters(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		format     plot.TickFormatter
+		values     []float64
+		labels     []string
+		annotation string
+	}{
+		{
+			name:       "scientific",
+			format:     plot.ScientificFormat{},
+			values:     []float64{0, 5e5, 1e6, 1.5e6},
+			labels:     []string{"0", "0.5", "1", "1.5"},
+			annotation: "×10⁶",
+		},
+		{
+			name:       "scientific negative exponent",
+			format:     plot.ScientificFormat{Prec: 2},
+			values:     []float64{0, 0.00012, 0.000123},
+			labels:     []string{"0", "1.2", "1.2"},
+			annotation: "×10⁻⁴",
+		},
+		{
+			name:       "scientific latex",
+			format:     plot.ScientificFormat{Handler: text.Latex{}},
+			values:     []float64{0, 0.00012, 0.00015},
+			labels:     []string{"0", "1.2", "1.5"},
+			annotation: `$\times 10^{-4}$`,
+		},
+		{
+			name:       "scientific markup",
+			format:     plot.ScientificFormat{Handler: &text.Markup{}},
+			values:     []float64{0, 5e5, 1e6, 1.5e6},
+			labels:     []string{"0", "0.5", "1", "1.5"},
+			annotation: "×10^{6}",
+		},
+		{
+			name:   "scientific unit",
+			format: plot.ScientificFormat{},
+			values: []float64{1, 2.5},
+			labels: []string{"1", "2.5"},
+		},
+		{
+			name:   "engineering",
+			format: plot.EngineeringFormat{},
+			values: []float64{0, 150e3, 1.5e6, -2e-5},
+			labels: []string{"0", "150e3", "1.5e6", "-20e-6"},
+		},
+		{
+			name:   "SI",
+			format: plot.SIFormat{Unit: "Hz"},
+			values: []float64{0, 1500, 2e6, 2e-4},
+			labels: []string{"0 Hz", "1.5 kHz", "2 MHz", "200 µHz"},
+		},
+		{
+			name:   "SI without unit",
+			format: plot.SIFormat{},
+			values: []float64{0, 1500, 3e9},
+			labels: []string{"0", "1.5k", "3G"},
+		},
+		{
+			name:   "percent",
+			format: plot.PercentFormat{},
+			values: []float64{0, 0.07, 0.25, -1},
+			labels: []string{"0%", "7%", "25%", "-100%"},
+		},
+		{
+			name:   "currency",
+			format: plot.CurrencyFormat{Symbol: "$", Decimals: 2},
+			values: []float64{0, 1250, -1234567.891},
+			labels: []string{"$0.00", "$1,250.00", "-$1,234,567.89"},
+		},
+		{
+			name:   "currency suffix",
+			format: plot.CurrencyFormat{Symbol: "€", Suffix: true},
+			values: []float64{999, 1000},
+			labels: []string{"999 €", "1,000 €"},
+		},
+		{
+			name:       "offset",
+			format:     plot.OffsetFormat{},
+			values:     []float64{1.7e9 + 100, 1.7e9 + 200, 1.7e9 + 300},
+			labels:     []string{"100", "200", "300"},
+			annotation: "+1.7e+09",
+		},
+		{
+			name:       "offset narrow range",
+			format:     plot.OffsetFormat{},
+			values:     []float64{42.0001, 42.0003, 42.0005},
+			labels:     []string{"0.0001", "0.0003", "0.0005"},
+			annotation: "+42",
+		},
+		{
+			name:   "no offset",
+			format: plot.OffsetFormat{},
+			values: []float64{50, 55, 60},
+			labels: []string{"50", "55", "60"},
+		},
+	} {
+		labels, annotation := test.format.Format(test.values)
+		if !reflect.DeepEqual(labels, test.labels) {
+			t.Errorf("unexpected labels for %s: got:%q want:%q", test.name, labels, test.labels)
+		}
+		if annotation != test.annotation {
+			t.Errorf("unexpected annotation for %s: got:%q want:%q", test.name, annotation, test.annotation)
+		}
+	}
+}
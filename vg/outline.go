@@ -0,0 +1,158 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vg
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	stdfnt "golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+
+	"gonum.org/v1/plot/font"
+)
+
+// OutlineText creates a canvas that draws text as filled glyph
+// outlines on the provided canvas, instead of as text in a font.
+// Documents drawn with it do not depend on any font, at the cost of
+// larger documents and of text that can not be selected.
+//
+// The glyph outlines are taken from the OpenType data of the font
+// faces of the text, and glyphs are placed with the kerning of the
+// fonts, like Face.Width measures them. Since handlers draw text with
+// the FillString method of the canvas, both text.Plain and text.Latex
+// text is outlined.
+//
+// The returned canvas implements CanvasSizer and CanvasWriterTo
+// if the provided canvas does.
+func OutlineText(c Canvas) Canvas {
+	oc := outlineCanvas{c}
+	switch c := c.(type) {
+	case CanvasWriterTo:
+		return outlineWriterTo{outlineSizer{oc, c}, c}
+	case CanvasSizer:
+		return outlineSizer{oc, c}
+	default:
+		return oc
+	}
+}
+
+type outlineCanvas struct {
+	Canvas
+}
+
+// FillString fills the outlines of the glyphs of the text
+// at the specified location using the given font.
+// If the font size is zero, the text is not drawn.
+func (c outlineCanvas) FillString(f font.Face, pt Point, text string) {
+	if f.Font.Size == 0 {
+		return
+	}
+	p := outline(f, pt, text)
+	if len(p) == 0 {
+		return
+	}
+	c.Fill(p)
+}
+
+type outlineSizer struct {
+	outlineCanvas
+	c CanvasSizer
+}
+
+// Size returns the width and height of the canvas.
+func (c outlineSizer) Size() (w, h Length) {
+	return c.c.Size()
+}
+
+type outlineWriterTo struct {
+	outlineSizer
+	c CanvasWriterTo
+}
+
+// WriteTo writes the canvas to w.
+func (c outlineWriterTo) WriteTo(w io.Writer) (int64, error) {
+	return c.c.WriteTo(w)
+}
+
+// outline returns the path of the outlines of the glyphs
+// of the text drawn at pt using the given font.
+func outline(f font.Face, pt Point, text string) Path {
+	var (
+		// Glyphs are loaded with one pixel per font unit,
+		// to be scaled to the size of the font.
+		pixelsPerEm = fixed.Int26_6(f.Face.UnitsPerEm())
+		scale       = f.Font.Size / Length(pixelsPerEm)
+
+		path    Path
+		x       fixed.Int26_6
+		hasPrev = false
+		buf     sfnt.Buffer
+		prev    sfnt.GlyphIndex
+		hinting = stdfnt.HintingNone
+	)
+	// at returns the canvas point of the glyph point p,
+	// whose y axis points down.
+	at := func(p fixed.Point26_6) Point {
+		return Point{
+			X: pt.X + Length(x+p.X)*scale,
+			Y: pt.Y - Length(p.Y)*scale,
+		}
+	}
+	for _, r := range text {
+		idx, err := f.Face.GlyphIndex(&buf, r)
+		if err != nil {
+			panic(fmt.Errorf("vg: could not get glyph index: %w", err))
+		}
+		if hasPrev {
+			kern, err := f.Face.Kern(&buf, prev, idx, pixelsPerEm, hinting)
+			switch {
+			case err == nil:
+				x += kern
+			case errors.Is(err, sfnt.ErrNotFound):
+				// no-op
+			default:
+				panic(fmt.Errorf("vg: could not get kerning: %w", err))
+			}
+		}
+		segs, err := f.Face.LoadGlyph(&buf, idx, pixelsPerEm, nil)
+		if err != nil {
+			panic(fmt.Errorf("vg: could not load glyph of %q: %w", r, err))
+		}
+		for i, seg := range segs {
+			switch seg.Op {
+			case sfnt.SegmentOpMoveTo:
+				if i > 0 {
+					path.Close()
+				}
+				path.Move(at(seg.Args[0]))
+			case sfnt.SegmentOpLineTo:
+				path.Line(at(seg.Args[0]))
+			case sfnt.SegmentOpQuadTo:
+				path.QuadTo(at(seg.Args[0]), at(seg.Args[1]))
+			case sfnt.SegmentOpCubeTo:
+				path.CubeTo(at(seg.Args[0]), at(seg.Args[1]), at(seg.Args[2]))
+			}
+		}
+		if len(segs) > 0 {
+			path.Close()
+		}
+		adv, err := f.Face.GlyphAdvance(&buf, idx, pixelsPerEm, hinting)
+		if err != nil {
+			panic(fmt.Errorf("vg: could not retrieve glyph's advance: %w", err))
+		}
+		x += adv
+		prev, hasPrev = idx, true
+	}
+	return path
+}
+
+var (
+	_ Canvas         = outlineCanvas{}
+	_ CanvasSizer    = outlineSizer{}
+	_ CanvasWriterTo = outlineWriterTo{}
+)
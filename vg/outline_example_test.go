@@ -0,0 +1,61 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vg_test
+
+import (
+	"log"
+	"os"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/font/liberation"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/text"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgsvg"
+)
+
+func ExampleOutlineText() {
+	p := plot.New()
+	p.Title.Text = "Outlined text, AVAWAY"
+	p.X.Label.Text = `$\frac{\sqrt{x}}{2\pi\Gamma}$`
+	p.X.Label.TextStyle.Handler = text.Latex{
+		Fonts: font.NewCache(liberation.Collection()),
+	}
+	p.Y.Label.Text = "Temperature (°C)"
+
+	line := plotter.NewFunction(func(x float64) float64 { return x * x })
+	p.Add(line, plotter.NewGrid())
+	p.X.Min = 0
+	p.X.Max = 1
+	p.Y.Min = 0
+	p.Y.Max = 1
+
+	// Draw the text of the plot as filled glyph outlines,
+	// so the SVG document does not depend on any font.
+	const (
+		width  = 10 * vg.Centimeter
+		height = 6 * vg.Centimeter
+	)
+	c := vgsvg.New(width, height)
+	p.Draw(draw.NewCanvas(vg.OutlineText(c), width, height))
+
+	f, err := os.Create("testdata/outline_text.svg")
+	if err != nil {
+		log.Fatalf("could not create output file: %+v", err)
+	}
+	defer f.Close()
+
+	_, err = c.WriteTo(f)
+	if err != nil {
+		log.Fatalf("could not write SVG document: %+v", err)
+	}
+
+	err = f.Close()
+	if err != nil {
+		log.Fatalf("could not close output file: %+v", err)
+	}
+}
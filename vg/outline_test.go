@@ -0,0 +1,124 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vg_test
+
+import (
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/font/liberation"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/recorder"
+	"gonum.org/v1/plot/vg/vgimg"
+	"gonum.org/v1/plot/vg/vgsvg"
+)
+
+func TestOutlineText(t *testing.T) {
+	cmpimg.CheckPlot(ExampleOutlineText, t, "outline_text.svg")
+
+	svg, err := os.ReadFile("testdata/outline_text_golden.svg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(svg), "<text") {
+		t.Errorf("outlined SVG document holds text elements")
+	}
+}
+
+func TestOutlineTextPath(t *testing.T) {
+	fonts := font.NewCache(liberation.Collection())
+	face := fonts.Lookup(font.Font{Typeface: "Liberation", Variant: "Serif"}, 100)
+
+	for _, txt := range []string{"x", "Hello", "AV", "g'ïÅ"} {
+		t.Run(txt, func(t *testing.T) {
+			rec := new(recorder.Canvas)
+			c := vg.OutlineText(rec)
+			pt := vg.Point{X: 10, Y: 20}
+			c.FillString(face, pt, txt)
+
+			if len(rec.Actions) != 1 {
+				t.Fatalf("invalid number of actions: got=%d, want=1", len(rec.Actions))
+			}
+			fill, ok := rec.Actions[0].(*recorder.Fill)
+			if !ok {
+				t.Fatalf("invalid action: got=%T, want=%T", rec.Actions[0], fill)
+			}
+
+			// The outline spans the width of the text,
+			// and lies within the extents of the font.
+			min := vg.Point{X: vg.Length(math.Inf(+1)), Y: vg.Length(math.Inf(+1))}
+			max := vg.Point{X: vg.Length(math.Inf(-1)), Y: vg.Length(math.Inf(-1))}
+			for _, comp := range fill.Path {
+				if comp.Type == vg.CloseComp {
+					continue
+				}
+				for _, p := range append(comp.Control, comp.Pos) {
+					min = vg.Point{X: vg.Length(math.Min(float64(min.X), float64(p.X))), Y: vg.Length(math.Min(float64(min.Y), float64(p.Y)))}
+					max = vg.Point{X: vg.Length(math.Max(float64(max.X), float64(p.X))), Y: vg.Length(math.Max(float64(max.Y), float64(p.Y)))}
+				}
+			}
+			ext := face.Extents()
+			width := face.Width(txt)
+			const tol = 15 // side bearings, in points.
+			if math.Abs(float64(min.X-pt.X)) > tol || math.Abs(float64(max.X-pt.X-width)) > tol {
+				t.Errorf("invalid horizontal extent: got=[%v, %v], want about [%v, %v]", min.X, max.X, pt.X, pt.X+width)
+			}
+			if min.Y < pt.Y+ext.Descent*-1-1 || max.Y > pt.Y+ext.Ascent+1 {
+				t.Errorf("invalid vertical extent: got=[%v, %v], want within [%v, %v]", min.Y, max.Y, pt.Y-ext.Descent, pt.Y+ext.Ascent)
+			}
+		})
+	}
+}
+
+func TestOutlineTextKerning(t *testing.T) {
+	fonts := font.NewCache(liberation.Collection())
+	face := fonts.Lookup(font.Font{Typeface: "Liberation", Variant: "Serif"}, 100)
+
+	// right returns the rightmost point of the outline of the text.
+	right := func(txt string) vg.Length {
+		rec := new(recorder.Canvas)
+		vg.OutlineText(rec).FillString(face, vg.Point{}, txt)
+		max := vg.Length(math.Inf(-1))
+		for _, comp := range rec.Actions[0].(*recorder.Fill).Path {
+			if comp.Type != vg.CloseComp {
+				max = vg.Length(math.Max(float64(max), float64(comp.Pos.X)))
+			}
+		}
+		return max
+	}
+
+	kern := face.Width("AV") - face.Width("A") - face.Width("V")
+	if kern >= 0 {
+		t.Fatalf("no kerning between A and V")
+	}
+	got := right("AV") - right("V") - face.Width("A")
+	if math.Abs(float64(got-kern)) > 1e-6 {
+		t.Errorf("invalid kerning: got=%v, want=%v", got, kern)
+	}
+}
+
+func TestOutlineTextCanvas(t *testing.T) {
+	if _, ok := vg.OutlineText(new(recorder.Canvas)).(vg.CanvasSizer); ok {
+		t.Errorf("unexpected sized outline canvas of an unsized canvas")
+	}
+
+	for _, c := range []vg.CanvasWriterTo{
+		vgsvg.New(10, 20),
+		vgimg.PngCanvas{Canvas: vgimg.New(10, 20)},
+	} {
+		oc, ok := vg.OutlineText(c).(vg.CanvasWriterTo)
+		if !ok {
+			t.Fatalf("outline canvas of %T is not a vg.CanvasWriterTo", c)
+		}
+		w, h := oc.Size()
+		if w != 10 || h != 20 {
+			t.Errorf("invalid size of outline canvas of %T: got=(%v, %v), want=(10, 20)", c, w, h)
+		}
+	}
+}
@@ -64,6 +64,8 @@ type Canvas struct {
 	// Switch to embed fonts in PDF file.
 	// The default is to embed fonts.
 	// This makes the PDF file more portable but also larger.
+	// Embedded TrueType fonts are reduced to the glyphs drawn
+	// with them.
 	embed bool
 }
 
@@ -95,6 +97,8 @@ func New(w, h vg.Length) *Canvas {
 
 // EmbedFonts specifies whether the resulting PDF canvas should
 // embed the fonts or not.
+// Embedded TrueType fonts are subset to the glyphs drawn on the
+// canvas, and their text remains selectable.
 // EmbedFonts returns the previous value before modification.
 func (c *Canvas) EmbedFonts(v bool) bool {
 	prev := c.embed
@@ -180,14 +184,7 @@ func (c *Canvas) FillString(fnt font.Face, pt vg.Point, str string) {
 	}
 
 	c.font(fnt, pt)
-	style := ""
-	if fnt.Font.Weight == stdfnt.WeightBold {
-		style += "B"
-	}
-	if fnt.Font.Style == stdfnt.StyleItalic {
-		style += "I"
-	}
-	c.doc.SetFont(fnt.Name(), style, c.unit(fnt.Font.Size))
+	c.doc.SetFont(fnt.Name(), pdfStyle(fnt), c.unit(fnt.Font.Size))
 
 	c.Push()
 	defer c.Pop()
@@ -205,14 +202,7 @@ func (c *Canvas) FillString(fnt font.Face, pt vg.Point, str string) {
 
 func (c *Canvas) sbounds(fnt font.Face, txt string) (left, top, right, bottom float64) {
 	_, h := c.doc.GetFontSize()
-	style := ""
-	if fnt.Font.Weight == stdfnt.WeightBold {
-		style += "B"
-	}
-	if fnt.Font.Style == stdfnt.StyleItalic {
-		style += "I"
-	}
-	d := c.doc.GetFontDesc(fnt.Name(), style)
+	d := c.doc.GetFontDesc(fnt.Name(), pdfStyle(fnt))
 	if d.Ascent == 0 {
 		// not defined (standard font?), use average of 81%
 		top = 0.81 * h
@@ -252,14 +242,48 @@ func (c *Canvas) font(fnt font.Face, pt vg.Point) {
 	if err != nil {
 		log.Panicf("vgpdf: could not generate font %q data for PDF: %+v", name, err)
 	}
+	c.fonts[fnt.Font] = struct{}{}
+
+	if c.embed && isTrueType(raw.Bytes()) {
+		// Unicode fonts are reduced to the glyphs
+		// drawn with them when the document is written,
+		// and map them back to text with a ToUnicode table.
+		c.doc.AddUTF8FontFromBytes(name, pdfStyle(fnt), raw.Bytes())
+		return
+	}
 
 	zdata, jdata, err := getFont(key, raw.Bytes(), codePageEncoding)
 	if err != nil {
 		log.Panicf("vgpdf: could not generate font data for PDF: %v", err)
 	}
 
-	c.fonts[fnt.Font] = struct{}{}
-	c.doc.AddFontFromBytes(name, "", jdata, zdata)
+	c.doc.AddFontFromBytes(name, pdfStyle(fnt), jdata, zdata)
+}
+
+// pdfStyle returns the PDF font style of the font face.
+func pdfStyle(fnt font.Face) string {
+	style := ""
+	if fnt.Font.Weight == stdfnt.WeightBold {
+		style += "B"
+	}
+	if fnt.Font.Style == stdfnt.StyleItalic {
+		style += "I"
+	}
+	return style
+}
+
+// isTrueType returns whether the font data holds
+// a single font with TrueType outlines.
+func isTrueType(raw []byte) bool {
+	if len(raw) < 4 {
+		return false
+	}
+	switch string(raw[:4]) {
+	case "\x00\x01\x00\x00", "true":
+		return true
+	default:
+		return false
+	}
 }
 
 // pdfPath processes a vg.Path and applies it to the canvas.
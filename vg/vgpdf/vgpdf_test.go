@@ -11,11 +11,22 @@ import (
 	"image/png"
 	"log"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
+	"unicode/utf16"
+
+	stdfnt "golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"rsc.io/pdf"
 
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/font/liberation"
 	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/text"
 	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
 	"gonum.org/v1/plot/vg/vgpdf"
@@ -85,6 +96,132 @@ func TestEmbedFonts(t *testing.T) {
 	}
 }
 
+func TestEmbedFontsSubset(t *testing.T) {
+	cache := font.NewCache(liberation.Collection())
+	c := vgpdf.New(200, 100)
+	for i, tc := range []struct {
+		fnt font.Font
+		txt string
+	}{
+		{fnt: font.Font{Typeface: "Liberation", Variant: "Serif"}, txt: "Temperature (±2°C)"},
+		{fnt: font.Font{Typeface: "Liberation", Variant: "Serif", Weight: stdfnt.WeightBold}, txt: "Bold"},
+		{fnt: font.Font{Typeface: "Liberation", Variant: "Sans", Style: stdfnt.StyleItalic}, txt: "αβγ → Ω"},
+	} {
+		face := cache.Lookup(tc.fnt, 12)
+		c.FillString(face, vg.Point{X: 10, Y: vg.Length(20 + 20*i)}, tc.txt)
+	}
+
+	var buf bytes.Buffer
+	_, err := c.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("could not write canvas: %+v", err)
+	}
+
+	// A whole Liberation font is more than 300kB.
+	if size := buf.Len(); size > 100<<10 {
+		t.Errorf("embedded fonts not subset: document is %d bytes", size)
+	}
+
+	doc, err := pdf.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("could not read document: %+v", err)
+	}
+	page := doc.Page(1)
+	for _, name := range page.Fonts() {
+		if page.Font(name).V.Key("ToUnicode").IsNull() {
+			t.Errorf("missing ToUnicode map of font %s", name)
+		}
+	}
+
+	// Text drawn with embedded fonts is encoded as big-endian UCS-2
+	// character codes, which rsc.io/pdf does not decode.
+	var raw []byte
+	pdf.Interpret(page.V.Key("Contents"), func(stk *pdf.Stack, op string) {
+		n := stk.Len()
+		args := make([]pdf.Value, n)
+		for i := n - 1; i >= 0; i-- {
+			args[i] = stk.Pop()
+		}
+		if op == "Tj" && n == 1 {
+			raw = append(raw, args[0].RawString()...)
+		}
+	})
+	codes := make([]uint16, len(raw)/2)
+	for i := range codes {
+		codes[i] = uint16(raw[2*i])<<8 | uint16(raw[2*i+1])
+	}
+	txt := string(utf16.Decode(codes))
+	for _, want := range []string{"Temperature (±2°C)", "Bold", "αβγ → Ω"} {
+		if !strings.Contains(txt, want) {
+			t.Errorf("missing text %q in document text %q", want, txt)
+		}
+	}
+}
+
+func TestEmbedFallbackFonts(t *testing.T) {
+	goreg, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		t.Fatalf("could not parse Go font: %+v", err)
+	}
+	fonts := font.NewCache(liberation.Collection())
+	fonts.AddFallback(font.Collection{
+		{Font: font.Font{Typeface: "Go"}, Face: goreg},
+	})
+
+	c := vgpdf.New(200, 100)
+	sty := text.Style{
+		Font:    font.Font{Typeface: "Liberation", Variant: "Serif", Size: 12},
+		Handler: text.Plain{Fonts: fonts},
+	}
+	sty.Handler.Draw(c, "AV 10⁻⁹ s", sty, vg.Point{X: 10, Y: 10})
+
+	var buf bytes.Buffer
+	_, err = c.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("could not write canvas: %+v", err)
+	}
+
+	doc, err := pdf.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("could not read document: %+v", err)
+	}
+	page := doc.Page(1)
+	var names []string
+	for _, name := range page.Fonts() {
+		names = append(names, page.Font(name).BaseFont())
+	}
+	if got, want := len(names), 2; got != want {
+		t.Fatalf("invalid number of fonts: got=%d (%q), want=%d", got, names, want)
+	}
+	for _, want := range []string{"liberationserif", "go"} {
+		if !strings.Contains(strings.Join(names, " "), want) {
+			t.Errorf("missing font %q in document fonts %q", want, names)
+		}
+	}
+
+	// Each run is drawn with its own font,
+	// as big-endian UCS-2 character codes.
+	var runs []string
+	pdf.Interpret(page.V.Key("Contents"), func(stk *pdf.Stack, op string) {
+		n := stk.Len()
+		args := make([]pdf.Value, n)
+		for i := n - 1; i >= 0; i-- {
+			args[i] = stk.Pop()
+		}
+		if op == "Tj" && n == 1 {
+			raw := args[0].RawString()
+			codes := make([]uint16, len(raw)/2)
+			for i := range codes {
+				codes[i] = uint16(raw[2*i])<<8 | uint16(raw[2*i+1])
+			}
+			runs = append(runs, string(utf16.Decode(codes)))
+		}
+	})
+	if got, want := runs, []string{"AV 10", "⁻⁹", " s"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("invalid text runs: got=%q, want=%q", got, want)
+	}
+}
+
 func TestArc(t *testing.T) {
 	pts := plotter.XYs{{X: 1, Y: 1}, {X: 2, Y: 2}}
 	scat, err := plotter.NewScatter(pts)
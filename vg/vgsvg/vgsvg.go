@@ -72,7 +72,15 @@ type Canvas struct {
 	// The default is to *not* embed fonts.
 	// Embedding fonts makes the SVG file larger but also more portable.
 	embed bool
-	fonts map[string]struct{} // set of already embedded fonts
+	fonts map[string]*svgFont // fonts to embed, by font description
+	order []*svgFont          // order holds the fonts to embed, in order of use.
+}
+
+// svgFont is a font to embed in the SVG document,
+// with the runes drawn with it.
+type svgFont struct {
+	face  font.Face
+	runes map[rune]struct{}
 }
 
 type context struct {
@@ -122,7 +130,7 @@ func NewWith(opts ...option) *Canvas {
 		buf:   buf,
 		stack: []context{{}},
 		embed: false,
-		fonts: make(map[string]struct{}),
+		fonts: make(map[string]*svgFont),
 	}
 
 	for _, opt := range opts {
@@ -364,7 +372,7 @@ func (c *Canvas) FillString(font font.Face, pt vg.Point, str string) {
 	)
 
 	if c.embed {
-		c.embedFont(name, font)
+		c.useFont(name, font, str)
 	}
 }
 
@@ -495,31 +503,50 @@ func svgWeightName(w xfnt.Weight) string {
 	}
 }
 
-func (c *Canvas) embedFont(name string, f font.Face) {
-	if _, dup := c.fonts[name]; dup {
-		return
+// useFont records the runes of the string drawn with the font
+// of the given description, to embed them when saving.
+func (c *Canvas) useFont(name string, f font.Face, str string) {
+	fnt, ok := c.fonts[name]
+	if !ok {
+		fnt = &svgFont{face: f, runes: make(map[rune]struct{})}
+		c.fonts[name] = fnt
+		c.order = append(c.order, fnt)
+	}
+	for _, r := range str {
+		fnt.runes[r] = struct{}{}
 	}
-	c.fonts[name] = struct{}{}
+}
 
+// embedFont embeds the font, reduced to the glyphs of the runes
+// drawn with it. Fonts that can not be subset are embedded whole.
+func (c *Canvas) embedFont(f *svgFont) {
 	raw := new(bytes.Buffer)
-	_, err := f.Face.WriteSourceTo(nil, raw)
+	_, err := f.face.Face.WriteSourceTo(nil, raw)
 	if err != nil {
 		panic(fmt.Errorf("vg/vgsvg: could not read font raw data: %+v", err))
 	}
+	runes := make([]rune, 0, len(f.runes))
+	for r := range f.runes {
+		runes = append(runes, r)
+	}
+	data := raw.Bytes()
+	if sub, err := font.Subset(data, runes); err == nil {
+		data = sub
+	}
 
 	fmt.Fprintf(c.hdr, "\t\t@font-face{\n")
-	fmt.Fprintf(c.hdr, "\t\t\tfont-family:%q;\n", svgFamilyName(f))
+	fmt.Fprintf(c.hdr, "\t\t\tfont-family:%q;\n", svgFamilyName(f.face))
 	fmt.Fprintf(c.hdr,
 		"\t\t\tfont-variant:%s;font-weight:%s;font-style:%s;\n",
-		svgVariantName(f.Font.Variant),
-		svgWeightName(f.Font.Weight),
-		svgStyleName(f.Font.Style),
+		svgVariantName(f.face.Font.Variant),
+		svgWeightName(f.face.Font.Weight),
+		svgStyleName(f.face.Font.Style),
 	)
 
 	fmt.Fprintf(
 		c.hdr,
 		"\t\t\tsrc: url(data:font/ttf;charset=utf-8;base64,%s) format(\"truetype\");\n",
-		base64.StdEncoding.EncodeToString(raw.Bytes()),
+		base64.StdEncoding.EncodeToString(data),
 	)
 	fmt.Fprintf(c.hdr, "\t\t}\n")
 }
@@ -540,6 +567,9 @@ func (c *Canvas) WriteTo(w io.Writer) (int64, error) {
 	b := &cwriter{w: bufio.NewWriter(w)}
 
 	if c.embed {
+		for _, f := range c.order {
+			c.embedFont(f)
+		}
 		fmt.Fprintf(c.hdr, "\t</style>\n</defs>\n")
 	}
 
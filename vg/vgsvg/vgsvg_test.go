@@ -6,12 +6,22 @@ package vgsvg_test
 
 import (
 	"bytes"
+	"encoding/base64"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/font/liberation"
 	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/text"
 	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
 	"gonum.org/v1/plot/vg/vgsvg"
@@ -39,6 +49,87 @@ func TestStandardFonts(t *testing.T) {
 	cmpimg.CheckPlot(Example_standardFonts, t, "standard_fonts.svg")
 }
 
+func TestEmbedFallbackFonts(t *testing.T) {
+	goreg, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		t.Fatalf("could not parse Go font: %+v", err)
+	}
+	fonts := font.NewCache(liberation.Collection())
+	fonts.AddFallback(font.Collection{
+		{Font: font.Font{Typeface: "Go"}, Face: goreg},
+	})
+
+	c := vgsvg.NewWith(
+		vgsvg.UseWH(5*vg.Centimeter, 5*vg.Centimeter),
+		vgsvg.EmbedFonts(true),
+	)
+	sty := text.Style{
+		Font:    font.Font{Typeface: "Liberation", Variant: "Serif", Size: 12},
+		Handler: text.Plain{Fonts: fonts},
+	}
+	sty.Handler.Draw(c, "10⁻⁹ s", sty, vg.Point{X: 10, Y: 10})
+
+	b := new(bytes.Buffer)
+	if _, err = c.WriteTo(b); err != nil {
+		t.Fatal(err)
+	}
+	svg := b.String()
+	if got, want := strings.Count(svg, "@font-face"), 2; got != want {
+		t.Errorf("invalid number of embedded fonts: got=%d, want=%d", got, want)
+	}
+	for _, family := range []string{"Liberation Serif", "Go"} {
+		if !strings.Contains(svg, "font-family:\""+family+"\";") {
+			t.Errorf("missing embedded font %q", family)
+		}
+	}
+}
+
+func TestEmbedFontsSubset(t *testing.T) {
+	fonts := font.NewCache(liberation.Collection())
+	c := vgsvg.NewWith(
+		vgsvg.UseWH(5*vg.Centimeter, 5*vg.Centimeter),
+		vgsvg.EmbedFonts(true),
+	)
+	face := fonts.Lookup(font.Font{Typeface: "Liberation", Variant: "Serif"}, 12)
+	c.FillString(face, vg.Point{X: 10, Y: 10}, "x-Axis")
+	c.FillString(face, vg.Point{X: 10, Y: 30}, "±2°C")
+
+	b := new(bytes.Buffer)
+	if _, err := c.WriteTo(b); err != nil {
+		t.Fatal(err)
+	}
+
+	m := regexp.MustCompile(`base64,([^)]+)\)`).FindAllStringSubmatch(b.String(), -1)
+	if len(m) != 1 {
+		t.Fatalf("invalid number of embedded fonts: got=%d, want=1", len(m))
+	}
+	raw, err := base64.StdEncoding.DecodeString(m[0][1])
+	if err != nil {
+		t.Fatalf("could not decode embedded font: %+v", err)
+	}
+	full := new(bytes.Buffer)
+	if _, err := face.Face.WriteSourceTo(nil, full); err != nil {
+		t.Fatal(err)
+	}
+	if len(raw) >= full.Len()/10 {
+		t.Errorf("embedded font not subset: got=%d bytes, full font=%d bytes", len(raw), full.Len())
+	}
+
+	fnt, err := sfnt.Parse(raw)
+	if err != nil {
+		t.Fatalf("could not parse embedded font: %+v", err)
+	}
+	var buf sfnt.Buffer
+	for _, r := range "x-Axis±2°C" {
+		if gid, _ := fnt.GlyphIndex(&buf, r); gid == 0 {
+			t.Errorf("missing embedded glyph of %q", r)
+		}
+	}
+	if gid, _ := fnt.GlyphIndex(&buf, 'Q'); gid != 0 {
+		t.Errorf("unexpected embedded glyph of 'Q'")
+	}
+}
+
 func TestNewWith(t *testing.T) {
 	p := plot.New()
 	p.Title.Text = "Scatter plot"
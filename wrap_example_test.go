@@ -0,0 +1,55 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"log"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// Example_wrap draws a plot with a long title, axis labels
+// and legend entries wrapped or truncated to a maximum width.
+func Example_wrap() {
+	p := plot.New()
+	p.Title.Text = "Monthly mean temperature and precipitation recorded at the weather station over the last decade"
+	p.Title.Wrap.Width = 8 * vg.Centimeter
+
+	p.X.Label.Text = "Month of the year, counted from the first month of the hydrological year"
+	p.X.Label.Wrap.Width = 6 * vg.Centimeter
+	p.Y.Label.Text = "Temperature anomaly relative to the 1961-1990 reference period (°C)"
+	p.Y.Label.Wrap.Width = 5 * vg.Centimeter
+	p.Y.Label.Wrap.MaxLines = 2
+
+	p.Legend.Top = true
+	p.Legend.Wrap.Width = 3 * vg.Centimeter
+	p.Legend.Wrap.Truncate = true
+
+	for i, name := range []string{
+		"Station A (daily means, homogenized)",
+		"Station B",
+	} {
+		pts := make(plotter.XYs, 12)
+		for j := range pts {
+			pts[j].X = float64(j + 1)
+			pts[j].Y = float64(i) + math.Sin(2*math.Pi*float64(j)/12)
+		}
+		line, err := plotter.NewLine(pts)
+		if err != nil {
+			log.Panic(err)
+		}
+		line.Dashes = []vg.Length{vg.Points(2 + 4*float64(i)), vg.Points(2)}
+		p.Add(line)
+		p.Legend.Add(name, line)
+	}
+
+	err := p.Save(10*vg.Centimeter, 10*vg.Centimeter, "testdata/wrap.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot_test
+
+import (
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/cmpimg"
+	"gonum.org/v1/plot/text"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestWrap(t *testing.T) {
+	cmpimg.CheckPlot(Example_wrap, t, "wrap.png")
+}
+
+func TestWrapDataCanvas(t *testing.T) {
+	const txt = "a text wrapped to lines"
+	c := draw.NewCanvas(new(recorder.Canvas), 200, 200)
+	for _, tc := range []struct {
+		name string
+		set  func(p *plot.Plot, wrap text.Wrap)
+		room func(c draw.Canvas) vg.Length
+	}{
+		{
+			name: "title",
+			set: func(p *plot.Plot, wrap text.Wrap) {
+				p.Title.Text = txt
+				p.Title.Wrap = wrap
+			},
+			room: func(c draw.Canvas) vg.Length { return c.Max.Y },
+		},
+		{
+			name: "x-label",
+			set: func(p *plot.Plot, wrap text.Wrap) {
+				p.X.Label.Text = txt
+				p.X.Label.Wrap = wrap
+			},
+			room: func(c draw.Canvas) vg.Length { return -c.Min.Y },
+		},
+		{
+			name: "y-label",
+			set: func(p *plot.Plot, wrap text.Wrap) {
+				p.Y.Label.Text = txt
+				p.Y.Label.Wrap = wrap
+			},
+			room: func(c draw.Canvas) vg.Length { return -c.Min.X },
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := plot.New()
+			tc.set(p, text.Wrap{})
+			unwrapped := tc.room(p.DataCanvas(c))
+
+			// The wrapped text takes more room from the data.
+			tc.set(p, text.Wrap{Width: 40})
+			wrapped := tc.room(p.DataCanvas(c))
+			if !(wrapped < unwrapped) {
+				t.Errorf("wrapped text not accounted for: got=%v, unwrapped=%v", wrapped, unwrapped)
+			}
+		})
+	}
+}